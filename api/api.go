@@ -1,8 +1,12 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/cloudfoundry-community/cfseeker/config"
@@ -15,9 +19,15 @@ import (
 // struct that can be used to perform operations
 type SeekerHandler func(http.ResponseWriter, *http.Request, *seeker.Seeker)
 
+//shutdownTimeout is how long requests in flight are given to finish when the
+// server is shut down
+const shutdownTimeout = 10 * time.Second
+
 var (
 	configuration *config.Config
 	defaultSeeker *seeker.Seeker
+	//seekers holds a seeker for each configured foundation, keyed by name
+	seekers map[string]*seeker.Seeker
 )
 
 // Initialize reads in the given configuration struct and performs the steps
@@ -50,30 +60,143 @@ func Initialize(conf *config.Config) (err error) {
 	//Eventually, I want to have a form of auth that just goes to the backend
 	// CF UAA, in which case, we wouldn't need a default seeker
 	if !skipDefaultSeeker {
-		defaultSeeker, err = seeker.NewSeeker(conf)
-		if err != nil {
-			return fmt.Errorf("Error while creating seeker backend: %s", err.Error())
+		saved := cacheFile{}
+		if conf.Server.CacheFile != "" {
+			saved = readCacheFile(conf.Server.CacheFile)
+		}
+
+		seekers = map[string]*seeker.Seeker{}
+		for _, name := range conf.FoundationNames() {
+			log.Debugf("Setting up seeker for foundation `%s`", name)
+			seekers[name], err = newFoundationSeeker(conf, name, saved.Foundations[name])
+			if err != nil {
+				return fmt.Errorf("Error while creating seeker backend for foundation `%s`: %s", name, err.Error())
+			}
 		}
+		defaultSeeker = seekers[conf.FoundationNames()[0]]
 
-		defaultSeeker.SetTTL(time.Duration(conf.Server.CacheTTL) * time.Second)
+		if conf.Server.CacheFile != "" && conf.Server.CacheSaveInterval > 0 {
+			startCacheSaver(conf.Server.CacheFile, time.Duration(conf.Server.CacheSaveInterval)*time.Second)
+		}
 	}
 
 	router := mux.NewRouter()
 	router.HandleFunc(MetaEndpoint, metaHandler).Methods("GET")
-	router.HandleFunc(FindEndpoint, auth(findHandler)).Methods("GET")
-	router.HandleFunc(InvalidateBOSHEndpoint, auth(invalidateBOSHCacheHandler)).Methods("DELETE")
-	router.HandleFunc(ConvertEndpoint, auth(convertHandler)).Methods("GET")
+	router.HandleFunc(LocateEndpoint, auth(locateHandler)).Methods("GET")
+	for _, route := range []struct {
+		path    string
+		method  string
+		handler SeekerHandler
+	}{
+		{FindEndpoint, "GET", findHandler},
+		{FindBatchEndpoint, "POST", findBatchHandler},
+		{HACheckEndpoint, "GET", haCheckHandler},
+		{DependenciesEndpoint, "GET", dependenciesHandler},
+		{InvalidateBOSHEndpoint, "DELETE", invalidateBOSHCacheHandler},
+		{InvalidateCFEndpoint, "DELETE", invalidateCFCacheHandler},
+		{ConvertEndpoint, "GET", convertHandler},
+		{ConvertBatchEndpoint, "POST", convertBatchHandler},
+		{ListEndpoint, "GET", listHandler},
+		{ListAnyDeploymentEndpoint, "GET", listHandler},
+		{WhoisEndpoint, "GET", whoisHandler},
+		{FindServiceEndpoint, "GET", findServiceHandler},
+		{SearchEndpoint, "GET", searchHandler},
+		{CompleteEndpoint, "GET", completeHandler},
+	} {
+		router.HandleFunc(route.path, auth(route.handler)).Methods(route.method)
+		router.HandleFunc(InFoundation(route.path), auth(inFoundation(route.handler))).Methods(route.method)
+	}
 	router.HandleFunc(WebEndpoint, auth(webHandler)).Methods("GET")
 	router.PathPrefix("/web").Handler(http.StripPrefix("/web", auth(webHandler)))
 
 	router.NotFoundHandler = notFoundHandler{}
 
+	server := &http.Server{Addr: fmt.Sprintf(":%d", conf.Server.Port), Handler: router}
+	stopped := make(chan struct{})
+	go shutdownOnSignal(server, conf.Server.CacheFile, stopped)
+
 	log.Debugf("Listening on port %d", conf.Server.Port)
-	err = http.ListenAndServe(fmt.Sprintf(":%d", conf.Server.Port), router)
+	err = server.ListenAndServe()
+	if err == http.ErrServerClosed {
+		<-stopped
+		return nil
+	}
 	//If we're here, something is terrible
 	return err
 }
 
+//shutdownOnSignal waits for SIGINT or SIGTERM, and then stops the server from
+// taking new requests, lets the requests in flight finish, and saves the BOSH VM
+// cache to the cache file if one is configured. stopped is closed once that is
+// all done.
+func shutdownOnSignal(server *http.Server, cacheFile string, stopped chan<- struct{}) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	sig := <-signals
+	log.Infof("Received %s. Shutting down", sig)
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	err := server.Shutdown(ctx)
+	if err != nil {
+		log.Errorf("Requests did not finish before shutting down: %s", err.Error())
+	}
+	if cacheFile != "" {
+		saveCacheFile(cacheFile)
+	}
+	close(stopped)
+}
+
+//newFoundationSeeker makes a seeker for the foundation with the given name,
+// fills its BOSH VM cache with what was saved for it, and starts deployment
+// discovery and the crawler if they are configured
+func newFoundationSeeker(conf *config.Config, name string, saved seeker.VMCacheSnapshot) (s *seeker.Seeker, err error) {
+	foundationConf, err := conf.Foundation(name)
+	if err != nil {
+		return
+	}
+
+	s, err = seeker.NewSeeker(foundationConf)
+	if err != nil {
+		return
+	}
+
+	s.SetTTL(time.Duration(conf.Server.CacheTTL) * time.Second)
+	s.SetListingTTL(time.Duration(conf.Server.ListingTTL) * time.Second)
+	s.SetCFCacheTTL(time.Duration(conf.Server.CFCacheTTL) * time.Second)
+	s.SetIndexTTL(time.Duration(conf.Server.IndexTTL) * time.Second)
+	if len(saved.Deployments) > 0 {
+		loaded, discarded := s.LoadVMCacheSnapshot(saved)
+		log.Infof("Loaded %d deployments from the cache file for foundation `%s`. Discarded %d that were too old or whose director is no longer configured", loaded, name, discarded)
+	}
+
+	s.StartDiscovery()
+	if conf.Server.CrawlInterval > 0 {
+		interval := time.Duration(conf.Server.CrawlInterval) * time.Second
+		staleness := time.Duration(conf.Server.CrawlStaleness) * time.Second
+		if staleness <= 0 {
+			staleness = 2 * interval
+		}
+		s.StartCrawler(interval, staleness, conf.Server.CrawlConcurrency)
+	}
+	return
+}
+
+//inFoundation wraps the given handler so that it is given the seeker for the
+// foundation named in the request path instead of the default seeker
+func inFoundation(h SeekerHandler) SeekerHandler {
+	return func(w http.ResponseWriter, r *http.Request, _ *seeker.Seeker) {
+		name := mux.Vars(r)[FoundationKey]
+		s, found := seekers[name]
+		if !found {
+			w.WriteHeader(404)
+			NewResponse(w).Err(fmt.Sprintf("No foundation named `%s` is configured", name)).Write()
+			return
+		}
+		h(w, r, s)
+	}
+}
+
 func validateServerConfig(conf config.ServerConfig) (err error) {
 	if conf.Port > 65535 || conf.Port < 0 {
 		err = fmt.Errorf("Port number %d is out of bounds", conf.Port)
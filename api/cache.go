@@ -10,3 +10,8 @@ func invalidateBOSHCacheHandler(w http.ResponseWriter, r *http.Request, s *seeke
 	s.InvalidateAll()
 	NewResponse(w).Message("BOSH VM info cache successfully cleared").Write()
 }
+
+func invalidateCFCacheHandler(w http.ResponseWriter, r *http.Request, s *seeker.Seeker) {
+	s.InvalidateCFCache()
+	NewResponse(w).Message("Cloud Controller name cache successfully cleared").Write()
+}
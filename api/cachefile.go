@@ -0,0 +1,89 @@
+package api
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/cloudfoundry-community/cfseeker/seeker"
+	"github.com/starkandwayne/goutils/log"
+)
+
+//cacheFile is what is written to the configured cache file. It holds the BOSH
+// VM cache of each foundation, keyed by foundation name.
+type cacheFile struct {
+	SavedAt     time.Time                         `json:"saved_at"`
+	Foundations map[string]seeker.VMCacheSnapshot `json:"foundations"`
+}
+
+//readCacheFile reads the BOSH VM caches saved at the given path. If the file
+// doesn't exist yet or can't be read, an empty cache file is returned, because
+// the caches can always be fetched again.
+func readCacheFile(path string) (ret cacheFile) {
+	ret.Foundations = map[string]seeker.VMCacheSnapshot{}
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			log.Infof("No cache file at `%s` yet. Starting with an empty BOSH VM cache", path)
+		} else {
+			log.Errorf("Could not read cache file `%s`: %s", path, err.Error())
+		}
+		return
+	}
+
+	err = json.Unmarshal(contents, &ret)
+	if err != nil {
+		log.Errorf("Could not parse cache file `%s`: %s", path, err.Error())
+		ret = cacheFile{Foundations: map[string]seeker.VMCacheSnapshot{}}
+	}
+	return
+}
+
+//saveCacheFile writes the BOSH VM cache of every foundation to the given path.
+// The file is written next to the old one and then moved over it, so that a
+// crash partway through can't leave half of a cache file behind.
+func saveCacheFile(path string) {
+	out := cacheFile{SavedAt: time.Now(), Foundations: map[string]seeker.VMCacheSnapshot{}}
+	for name, s := range seekers {
+		out.Foundations[name] = s.SnapshotVMCache()
+	}
+
+	contents, err := json.Marshal(out)
+	if err != nil {
+		log.Errorf("Could not marshal BOSH VM cache: %s", err.Error())
+		return
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".")
+	if err != nil {
+		log.Errorf("Could not create cache file next to `%s`: %s", path, err.Error())
+		return
+	}
+	_, err = tmp.Write(contents)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		log.Errorf("Could not write cache file `%s`: %s", path, err.Error())
+		return
+	}
+	log.Debugf("Saved BOSH VM cache to `%s`", path)
+}
+
+//startCacheSaver launches a goroutine that saves the BOSH VM cache of every
+// foundation to the given path every interval
+func startCacheSaver(path string, interval time.Duration) {
+	log.Debugf("Saving BOSH VM cache to `%s` every %s", path, interval)
+	go func() {
+		for {
+			time.Sleep(interval)
+			saveCacheFile(path)
+		}
+	}()
+}
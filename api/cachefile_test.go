@@ -0,0 +1,46 @@
+package api
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cloudfoundry-community/cfseeker/seeker"
+)
+
+func TestReadCacheFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cfseeker-cachefile")
+	if err != nil {
+		t.Fatalf("Could not make temp dir: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "vm-cache.json")
+	if got := readCacheFile(path); got.Foundations == nil || len(got.Foundations) != 0 {
+		t.Errorf("Expected a missing file to give an empty cache file, got %+v", got)
+	}
+
+	seekers = map[string]*seeker.Seeker{}
+	saveCacheFile(path)
+	if got := readCacheFile(path); got.Foundations == nil || got.SavedAt.IsZero() || time.Since(got.SavedAt) > time.Minute {
+		t.Errorf("Expected the saved cache file to be read back, got %+v", got)
+	}
+
+	for name, contents := range map[string]string{
+		"truncated": `{"saved_at": "2017-05-02T17:23:18Z", "foundations": {"default": {"deploy`,
+		"corrupt":   "\x00\xffnot json at all",
+		"empty":     "",
+		"wrong":     `{"foundations": ["not", "a", "map"]}`,
+	} {
+		err = ioutil.WriteFile(path, []byte(contents), 0600)
+		if err != nil {
+			t.Fatalf("Could not write %s cache file: %s", name, err)
+		}
+		got := readCacheFile(path)
+		if got.Foundations == nil || len(got.Foundations) != 0 {
+			t.Errorf("Expected a %s cache file to be ignored, got %+v", name, got)
+		}
+	}
+}
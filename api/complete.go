@@ -0,0 +1,39 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/cloudfoundry-community/cfseeker/commands"
+	"github.com/cloudfoundry-community/cfseeker/seeker"
+)
+
+const (
+	// CompleteTypeKey is the HTTP query key for the kind of name to complete in
+	// the Complete API call. One of org, space, or app.
+	CompleteTypeKey = "type"
+	// CompleteOrgNameKey is the HTTP query key for the Org Name to the Complete
+	// API call. Required to complete space and app names.
+	CompleteOrgNameKey = "org_name"
+	// CompleteSpaceNameKey is the HTTP query key for the Space Name to the
+	// Complete API call. Required to complete app names.
+	CompleteSpaceNameKey = "space_name"
+	// CompletePrefixKey is the HTTP query key for what has been typed of the
+	// name so far in the Complete API call.
+	CompletePrefixKey = "prefix"
+)
+
+func completeHandler(w http.ResponseWriter, r *http.Request, s *seeker.Seeker) {
+	output, err := commands.Complete(s, commands.CompleteInput{
+		Type:      r.FormValue(CompleteTypeKey),
+		OrgName:   r.FormValue(CompleteOrgNameKey),
+		SpaceName: r.FormValue(CompleteSpaceNameKey),
+		Prefix:    r.FormValue(CompletePrefixKey),
+	})
+
+	if err != nil {
+		writeCommandError(w, err)
+		return
+	}
+
+	NewResponse(w).AttachContents(output).Write()
+}
@@ -1,6 +1,8 @@
 package api
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/http"
 
 	"github.com/cloudfoundry-community/cfseeker/commands"
@@ -31,12 +33,25 @@ func convertHandler(w http.ResponseWriter, r *http.Request, s *seeker.Seeker) {
 	})
 
 	if err != nil {
-		if _, badRequest := err.(commands.InputError); badRequest {
-			w.WriteHeader(400)
-		} else {
-			w.WriteHeader(500)
-		}
-		NewResponse(w).Err(err.Error()).Write()
+		writeCommandError(w, err)
+		return
+	}
+
+	NewResponse(w).AttachContents(output).Write()
+}
+
+func convertBatchHandler(w http.ResponseWriter, r *http.Request, s *seeker.Seeker) {
+	in := commands.ConvertBatchInput{}
+	err := json.NewDecoder(r.Body).Decode(&in.GUIDs)
+	if err != nil {
+		w.WriteHeader(400)
+		NewResponse(w).Err(fmt.Sprintf("Could not parse request body as a JSON list of GUIDs: %s", err.Error())).Write()
+		return
+	}
+
+	output, err := commands.ConvertBatch(s, in)
+	if err != nil {
+		writeCommandError(w, err)
 		return
 	}
 
@@ -0,0 +1,39 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/cloudfoundry-community/cfseeker/commands"
+	"github.com/cloudfoundry-community/cfseeker/seeker"
+)
+
+const (
+	// DependenciesAppGUIDKey is the HTTP query key for the App GUID to the
+	// Dependencies API call.
+	DependenciesAppGUIDKey = "app_guid"
+	// DependenciesOrgNameKey is the HTTP query key for the Org Name to the
+	// Dependencies API call.
+	DependenciesOrgNameKey = "org_name"
+	// DependenciesSpaceNameKey is the HTTP query key for the Space Name to the
+	// Dependencies API call.
+	DependenciesSpaceNameKey = "space_name"
+	// DependenciesAppNameKey is the HTTP query key for the App Name to the
+	// Dependencies API call.
+	DependenciesAppNameKey = "app_name"
+)
+
+func dependenciesHandler(w http.ResponseWriter, r *http.Request, s *seeker.Seeker) {
+	output, err := commands.Dependencies(s, commands.DependenciesInput{
+		AppGUID:   r.FormValue(DependenciesAppGUIDKey),
+		OrgName:   r.FormValue(DependenciesOrgNameKey),
+		SpaceName: r.FormValue(DependenciesSpaceNameKey),
+		AppName:   r.FormValue(DependenciesAppNameKey),
+	})
+
+	if err != nil {
+		writeCommandError(w, err)
+		return
+	}
+
+	warnAbout(NewResponse(w), output).AttachContents(output).Write()
+}
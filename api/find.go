@@ -1,7 +1,10 @@
 package api
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/cloudfoundry-community/cfseeker/commands"
 	"github.com/cloudfoundry-community/cfseeker/seeker"
@@ -17,25 +20,87 @@ const (
 	FindSpaceNameKey = "space_name"
 	// FindAppNameKey is the HTTP query key for the App Name to the Find API call.
 	FindAppNameKey = "app_name"
+	// FindAllInstancesKey is the HTTP query key which, when true, makes the Find
+	// API call include instances that aren't running.
+	FindAllInstancesKey = "all_instances"
+	// FindRouteKey is the HTTP query key for the URL of a route to the Find API
+	// call. If given, the apps mapped to that route are found instead.
+	FindRouteKey = "route"
+	// FindUsageKey is the HTTP query key which, when true, makes the Find API call
+	// include the resource usage of each running instance.
+	FindUsageKey = "usage"
+	// FindVitalsKey is the HTTP query key which, when true, makes the Find API
+	// call include the vitals of the BOSH VM each running instance is on.
+	FindVitalsKey = "vitals"
 )
 
 func findHandler(w http.ResponseWriter, r *http.Request, s *seeker.Seeker) {
-	output, err := commands.Find(s, commands.FindInput{
-		AppGUID:   r.FormValue(FindAppGUIDKey),
-		OrgName:   r.FormValue(FindOrgNameKey),
-		SpaceName: r.FormValue(FindSpaceNameKey),
-		AppName:   r.FormValue(FindAppNameKey),
-	})
+	var output seeker.Output
+	var err error
+	if route := r.FormValue(FindRouteKey); route != "" {
+		output, err = commands.FindRoute(s, commands.FindRouteInput{
+			URL:          route,
+			AllInstances: formBool(r, FindAllInstancesKey),
+			Usage:        formBool(r, FindUsageKey),
+			Vitals:       formBool(r, FindVitalsKey),
+		})
+	} else if r.FormValue(FindAppGUIDKey) == "" && r.FormValue(FindAppNameKey) == "" && r.FormValue(FindOrgNameKey) != "" {
+		output, err = commands.FindScope(s, commands.FindScopeInput{
+			OrgName:      r.FormValue(FindOrgNameKey),
+			SpaceName:    r.FormValue(FindSpaceNameKey),
+			AllInstances: formBool(r, FindAllInstancesKey),
+			Usage:        formBool(r, FindUsageKey),
+			Vitals:       formBool(r, FindVitalsKey),
+		})
+	} else {
+		output, err = commands.Find(s, commands.FindInput{
+			AppGUID:      r.FormValue(FindAppGUIDKey),
+			OrgName:      r.FormValue(FindOrgNameKey),
+			SpaceName:    r.FormValue(FindSpaceNameKey),
+			AppName:      r.FormValue(FindAppNameKey),
+			AllInstances: formBool(r, FindAllInstancesKey),
+			Usage:        formBool(r, FindUsageKey),
+			Vitals:       formBool(r, FindVitalsKey),
+		})
+	}
+
+	if err != nil {
+		writeCommandError(w, err)
+		return
+	}
+
+	warnAbout(NewResponse(w), output).AttachContents(output).Write()
+}
 
+func findBatchHandler(w http.ResponseWriter, r *http.Request, s *seeker.Seeker) {
+	//The body must be decoded before the form is parsed, because parsing the form
+	// can consume the body
+	in := commands.FindBatchInput{}
+	err := json.NewDecoder(r.Body).Decode(&in.Apps)
 	if err != nil {
-		if _, badRequest := err.(commands.InputError); badRequest {
-			w.WriteHeader(400)
-		} else {
-			w.WriteHeader(500)
-		}
-		NewResponse(w).Err(err.Error()).Write()
+		w.WriteHeader(400)
+		NewResponse(w).Err(fmt.Sprintf("Could not parse request body as a JSON list of apps: %s", err.Error())).Write()
 		return
 	}
+	in.AllInstances = formBool(r, FindAllInstancesKey)
+	in.Usage = formBool(r, FindUsageKey)
+	in.Vitals = formBool(r, FindVitalsKey)
 
-	NewResponse(w).AttachContents(output).Write()
+	output, err := commands.FindBatch(s, in)
+	if err != nil {
+		writeCommandError(w, err)
+		return
+	}
+
+	warnAbout(NewResponse(w), output).AttachContents(output).Write()
+}
+
+//warnAbout attaches the warnings of the given command output to the response,
+// if it has any
+func warnAbout(r *Response, output seeker.Output) *Response {
+	warner, canWarn := output.(commands.Warner)
+	if canWarn && len(warner.Warnings()) > 0 {
+		r.Warn(strings.Join(warner.Warnings(), "; "))
+	}
+	return r
 }
@@ -0,0 +1,39 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/cloudfoundry-community/cfseeker/commands"
+	"github.com/cloudfoundry-community/cfseeker/seeker"
+)
+
+const (
+	// FindServiceGUIDKey is the HTTP query key for the service instance GUID to
+	// the FindService API call.
+	FindServiceGUIDKey = "service_guid"
+	// FindServiceOrgNameKey is the HTTP query key for the Org Name to the
+	// FindService API call.
+	FindServiceOrgNameKey = "org_name"
+	// FindServiceSpaceNameKey is the HTTP query key for the Space Name to the
+	// FindService API call.
+	FindServiceSpaceNameKey = "space_name"
+	// FindServiceNameKey is the HTTP query key for the service instance name to
+	// the FindService API call.
+	FindServiceNameKey = "service_name"
+)
+
+func findServiceHandler(w http.ResponseWriter, r *http.Request, s *seeker.Seeker) {
+	output, err := commands.FindService(s, commands.FindServiceInput{
+		ServiceGUID: r.FormValue(FindServiceGUIDKey),
+		OrgName:     r.FormValue(FindServiceOrgNameKey),
+		SpaceName:   r.FormValue(FindServiceSpaceNameKey),
+		ServiceName: r.FormValue(FindServiceNameKey),
+	})
+
+	if err != nil {
+		writeCommandError(w, err)
+		return
+	}
+
+	warnAbout(NewResponse(w), output).AttachContents(output).Write()
+}
@@ -0,0 +1,39 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/cloudfoundry-community/cfseeker/commands"
+	"github.com/cloudfoundry-community/cfseeker/seeker"
+)
+
+const (
+	// HACheckAppGUIDKey is the HTTP query key for the App GUID to the HA Check API
+	// call.
+	HACheckAppGUIDKey = "app_guid"
+	// HACheckOrgNameKey is the HTTP query key for the Org Name to the HA Check API
+	// call.
+	HACheckOrgNameKey = "org_name"
+	// HACheckSpaceNameKey is the HTTP query key for the Space Name to the HA Check
+	// API call.
+	HACheckSpaceNameKey = "space_name"
+	// HACheckAppNameKey is the HTTP query key for the App Name to the HA Check API
+	// call.
+	HACheckAppNameKey = "app_name"
+)
+
+func haCheckHandler(w http.ResponseWriter, r *http.Request, s *seeker.Seeker) {
+	output, err := commands.HACheck(s, commands.HACheckInput{
+		AppGUID:   r.FormValue(HACheckAppGUIDKey),
+		OrgName:   r.FormValue(HACheckOrgNameKey),
+		SpaceName: r.FormValue(HACheckSpaceNameKey),
+		AppName:   r.FormValue(HACheckAppNameKey),
+	})
+
+	if err != nil {
+		writeCommandError(w, err)
+		return
+	}
+
+	NewResponse(w).AttachContents(output).Write()
+}
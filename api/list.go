@@ -0,0 +1,36 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cloudfoundry-community/cfseeker/commands"
+	"github.com/cloudfoundry-community/cfseeker/seeker"
+	"github.com/gorilla/mux"
+)
+
+const (
+	// ListDeploymentKey is the path variable for the BOSH deployment in the List
+	// API call.
+	ListDeploymentKey = "deployment"
+	// ListJobKey is the path variable for the BOSH job name in the List API call.
+	ListJobKey = "job"
+	// ListIndexKey is the path variable for the BOSH VM index in the List API
+	// call.
+	ListIndexKey = "index"
+)
+
+func listHandler(w http.ResponseWriter, r *http.Request, s *seeker.Seeker) {
+	vars := mux.Vars(r)
+	output, err := commands.List(s, commands.ListInput{
+		VMName:     fmt.Sprintf("%s/%s", vars[ListJobKey], vars[ListIndexKey]),
+		Deployment: vars[ListDeploymentKey],
+	})
+
+	if err != nil {
+		writeCommandError(w, err)
+		return
+	}
+
+	NewResponse(w).AttachContents(output).Write()
+}
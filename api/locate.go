@@ -0,0 +1,32 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/cloudfoundry-community/cfseeker/commands"
+	"github.com/cloudfoundry-community/cfseeker/seeker"
+)
+
+const (
+	// LocateAppGUIDKey is the HTTP query key for the App GUID to the Locate API
+	// call.
+	LocateAppGUIDKey = "app_guid"
+	// LocateAppNameKey is the HTTP query key for the App Name to the Locate API
+	// call.
+	LocateAppNameKey = "app_name"
+)
+
+func locateHandler(w http.ResponseWriter, r *http.Request, s *seeker.Seeker) {
+	output, err := commands.Locate(seekers, commands.LocateInput{
+		AppGUID:     r.FormValue(LocateAppGUIDKey),
+		AppName:     r.FormValue(LocateAppNameKey),
+		Foundations: configuration.FoundationNames(),
+	})
+
+	if err != nil {
+		writeCommandError(w, err)
+		return
+	}
+
+	warnAbout(NewResponse(w), output).AttachContents(output).Write()
+}
@@ -5,11 +5,23 @@ import (
 	"net/http"
 
 	"github.com/cloudfoundry-community/cfseeker/config"
+	"github.com/cloudfoundry-community/cfseeker/seeker"
+	"github.com/starkandwayne/goutils/log"
 )
 
 //MetaOutput gives meta information about this cfseeker server.
 type MetaOutput struct {
 	Version string `json:"version" yaml:"version"`
+	//Foundations lists the names of the foundations this server can search,
+	// with the default foundation first
+	Foundations []string `json:"foundations" yaml:"foundations"`
+	//Deployments lists the BOSH deployments searched in each foundation, keyed
+	// by foundation name and then by director name. This includes deployments
+	// that were discovered.
+	Deployments map[string]map[string][]string `json:"deployments,omitempty" yaml:"deployments,omitempty"`
+	//CFCache gives the stats of the Cloud Controller name and GUID cache of each
+	// foundation, keyed by foundation name
+	CFCache map[string]seeker.CFCacheStats `json:"cf_cache,omitempty" yaml:"cf_cache,omitempty"`
 }
 
 //ReceiveJSON makes MetaOutput an implementation of SeekerOutput
@@ -20,7 +32,33 @@ func (m *MetaOutput) ReceiveJSON(j []byte) (err error) {
 
 func metaHandler(w http.ResponseWriter, r *http.Request) {
 	output := &MetaOutput{
-		Version: config.Version,
+		Version:     config.Version,
+		Foundations: configuration.FoundationNames(),
+	}
+
+	for _, name := range output.Foundations {
+		s := seekers[name]
+		if s == nil {
+			continue
+		}
+		if output.CFCache == nil {
+			output.CFCache = map[string]seeker.CFCacheStats{}
+		}
+		output.CFCache[name] = s.CFCacheStats()
+
+		if !s.BOSHConfigured() {
+			continue
+		}
+
+		deployments, err := s.Deployments()
+		if err != nil {
+			log.Warnf("Could not list deployments of foundation `%s`: %s", name, err.Error())
+			continue
+		}
+		if output.Deployments == nil {
+			output.Deployments = map[string]map[string][]string{}
+		}
+		output.Deployments[name] = deployments
 	}
 	NewResponse(w).AttachContents(output).Write()
 }
@@ -0,0 +1,105 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cloudfoundry-community/cfseeker/config"
+	"github.com/cloudfoundry-community/cfseeker/seeker"
+)
+
+//fakeCF serves just enough of the CF API to look up the org `sandbox`, its
+// space `dev`, and the app `web` in that space
+func fakeCF(t *testing.T) *httptest.Server {
+	var server *httptest.Server
+	resources := map[string][]string{
+		"/v2/organizations": {"sandbox", "production"},
+		"/v2/spaces":        {"dev"},
+		"/v2/apps":          {"web"},
+	}
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/info":
+			fmt.Fprintf(w, `{"authorization_endpoint": %[1]q, "token_endpoint": %[1]q}`, server.URL)
+			return
+		case "/oauth/token":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"access_token": "token", "token_type": "bearer", "expires_in": 3600}`)
+			return
+		}
+		names, found := resources[r.URL.Path]
+		if !found {
+			t.Errorf("Unexpected request to the CF API for %s", r.URL)
+			w.WriteHeader(404)
+			return
+		}
+		var wanted string
+		for _, q := range r.URL.Query()["q"] {
+			if strings.HasPrefix(q, "name:") {
+				wanted = strings.TrimPrefix(q, "name:")
+			}
+		}
+		listed := []string{}
+		for _, name := range names {
+			if wanted == "" || name == wanted {
+				listed = append(listed, fmt.Sprintf(`{"metadata": {"guid": "%s-guid"}, "entity": {"name": %q}}`, name, name))
+			}
+		}
+		fmt.Fprintf(w, `{"total_results": %d, "total_pages": 1, "resources": [%s]}`, len(listed), strings.Join(listed, ","))
+	}))
+	return server
+}
+
+func TestMisspelledNamesAreNotFound(t *testing.T) {
+	cf := fakeCF(t)
+	defer cf.Close()
+
+	s, err := seeker.NewSeeker(&config.Config{
+		CF:          config.CFConfig{APIAddress: cf.URL, ClientID: "cfseeker", ClientSecret: "secret"},
+		BOSH:        config.BOSHConfig{SkipBOSH: true},
+		HTTPTimeout: 5,
+	})
+	if err != nil {
+		t.Fatalf("Could not make seeker: %s", err)
+	}
+
+	tests := []struct {
+		name    string
+		handler SeekerHandler
+		query   string
+		suggest string
+	}{
+		{"find", findHandler, "org_name=sandbx&space_name=dev&app_name=web", "sandbox"},
+		{"find scope", findHandler, "org_name=sandbx", "sandbox"},
+		{"convert org", convertHandler, "org_name=sandbx", "sandbox"},
+		{"convert space", convertHandler, "org_name=sandbox&space_name=deb", "dev"},
+		{"convert app", convertHandler, "org_name=sandbox&space_name=dev&app_name=wbe", "web"},
+	}
+	for _, test := range tests {
+		r := httptest.NewRequest("GET", "/?"+test.query, nil)
+		w := httptest.NewRecorder()
+		test.handler(w, r, s)
+
+		if w.Code != 404 {
+			t.Errorf("%s: expected status 404, got %d: %s", test.name, w.Code, w.Body.String())
+			continue
+		}
+		var body struct {
+			Meta struct {
+				Error string `json:"error"`
+			} `json:"meta"`
+		}
+		err = json.Unmarshal(w.Body.Bytes(), &body)
+		if err != nil {
+			t.Errorf("%s: could not decode response body: %s", test.name, err)
+			continue
+		}
+		if suggestion := fmt.Sprintf("Did you mean: `%s`?", test.suggest); !strings.Contains(body.Meta.Error, suggestion) {
+			t.Errorf("%s: expected the error to suggest `%s`, got %q", test.name, test.suggest, body.Meta.Error)
+		}
+	}
+}
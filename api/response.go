@@ -4,7 +4,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 
+	"github.com/cloudfoundry-community/cfseeker/commands"
 	"github.com/cloudfoundry-community/cfseeker/seeker"
 )
 
@@ -95,3 +97,24 @@ func (r *Response) Write() {
 	}
 	r.writer.Write(r.Bytes())
 }
+
+//formBool returns true if the form value with the given key is set to something
+// that parses as true, such as "true" or "1"
+func formBool(r *http.Request, key string) bool {
+	ret, err := strconv.ParseBool(r.FormValue(key))
+	return err == nil && ret
+}
+
+//writeCommandError responds with the error a command returned. The status is
+// 400 if the input of the command was bad, 404 if what it was asked about
+// doesn't exist, and 500 otherwise.
+func writeCommandError(w http.ResponseWriter, err error) {
+	code := 500
+	switch err.(type) {
+	case commands.InputError:
+		code = 400
+	case commands.NotFoundError, seeker.NameNotFoundError:
+		code = 404
+	}
+	NewResponse(w).Code(code).Err(err.Error()).Write()
+}
@@ -0,0 +1,27 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/cloudfoundry-community/cfseeker/commands"
+	"github.com/cloudfoundry-community/cfseeker/seeker"
+)
+
+const (
+	// SearchPatternKey is the HTTP query key for the pattern to match app names
+	// against in the Search API call.
+	SearchPatternKey = "q"
+)
+
+func searchHandler(w http.ResponseWriter, r *http.Request, s *seeker.Seeker) {
+	output, err := commands.Search(s, commands.SearchInput{
+		Pattern: r.FormValue(SearchPatternKey),
+	})
+
+	if err != nil {
+		writeCommandError(w, err)
+		return
+	}
+
+	NewResponse(w).AttachContents(output).Write()
+}
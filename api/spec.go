@@ -1,16 +1,66 @@
 package api
 
+import "strings"
+
 const (
 	//FindEndpoint is the URL endpoint corresponding to calling the Find command
 	FindEndpoint = "/v1/apps"
+	//FindBatchEndpoint is the URL endpoint corresponding to calling the Find
+	// command for many apps at once
+	FindBatchEndpoint = "/v1/apps/batch"
+	//HACheckEndpoint is the URL endpoint corresponding to calling the HA Check
+	// command
+	HACheckEndpoint = "/v1/apps/ha"
+	//DependenciesEndpoint is the URL endpoint corresponding to calling the
+	// Dependencies command
+	DependenciesEndpoint = "/v1/apps/dependencies"
 	// MetaEndpoint is the URL endpoint corresponding to getting meta information
 	// about this cfseeker server
 	MetaEndpoint = "/v1/meta"
 	// InvalidateBOSHEndpoint is the endpoint corresponding to manipulation of the
 	// BOSH VM info cache
 	InvalidateBOSHEndpoint = "/v1/cache/bosh"
+	// InvalidateCFEndpoint is the endpoint corresponding to manipulation of the
+	// Cloud Controller name and GUID cache
+	InvalidateCFEndpoint = "/v1/cache/cf"
 	//WebEndpoint is the path to the web UI
 	WebEndpoint = "/"
 	//ConvertEndpoint is the path corresponding to the Convert API call
 	ConvertEndpoint = "/v1/convert"
+	//ConvertBatchEndpoint is the path corresponding to the Convert API call for
+	// many GUIDs at once
+	ConvertBatchEndpoint = "/v1/convert/batch"
+	//ListEndpoint is the path corresponding to the List API call for a VM in a
+	// specific deployment
+	ListEndpoint = "/v1/vms/{deployment}/{job}/{index}/instances"
+	//ListAnyDeploymentEndpoint is the path corresponding to the List API call
+	// for VMs with the given name in any configured deployment
+	ListAnyDeploymentEndpoint = "/v1/vms/{job}/{index}/instances"
+	//WhoisEndpoint is the path corresponding to the Whois API call
+	WhoisEndpoint = "/v1/instances"
+	//FindServiceEndpoint is the path corresponding to the FindService API call
+	FindServiceEndpoint = "/v1/services"
+	//SearchEndpoint is the path corresponding to the Search API call
+	SearchEndpoint = "/v1/search"
+	//CompleteEndpoint is the path corresponding to the Complete API call
+	CompleteEndpoint = "/v1/complete"
 )
+
+const (
+	//FoundationKey is the path variable naming the foundation in endpoints made
+	// with InFoundation
+	FoundationKey = "foundation"
+	//foundationPrefix replaces the /v1 prefix of an endpoint to make the version
+	// of it that targets a specific foundation
+	foundationPrefix = "/v1/foundations/{" + FoundationKey + "}"
+	//LocateEndpoint is the path corresponding to the Locate API call, which
+	// searches every foundation
+	LocateEndpoint = "/v1/locate"
+)
+
+//InFoundation returns the version of the given endpoint that targets the
+// foundation named in the path, instead of the default foundation. For example,
+// /v1/apps becomes /v1/foundations/{foundation}/apps
+func InFoundation(endpoint string) string {
+	return foundationPrefix + strings.TrimPrefix(endpoint, "/v1")
+}
@@ -0,0 +1,36 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/cloudfoundry-community/cfseeker/commands"
+	"github.com/cloudfoundry-community/cfseeker/seeker"
+)
+
+const (
+	// WhoisHostKey is the HTTP query key for the IP address to look up in the
+	// Whois API call.
+	WhoisHostKey = "host"
+	// WhoisPortKey is the HTTP query key for the port to look up in the Whois
+	// API call.
+	WhoisPortKey = "port"
+	// WhoisContainerIPKey is the HTTP query key for the container overlay IP
+	// address to look up in the Whois API call, instead of a host and port.
+	WhoisContainerIPKey = "container_ip"
+)
+
+func whoisHandler(w http.ResponseWriter, r *http.Request, s *seeker.Seeker) {
+	output, err := commands.Whois(s, commands.WhoisInput{
+		Host: r.FormValue(WhoisHostKey),
+		Port: r.FormValue(WhoisPortKey),
+
+		ContainerIP: r.FormValue(WhoisContainerIPKey),
+	})
+
+	if err != nil {
+		writeCommandError(w, err)
+		return
+	}
+
+	NewResponse(w).AttachContents(output).Write()
+}
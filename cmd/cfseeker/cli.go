@@ -7,6 +7,8 @@ import (
 	"io/ioutil"
 	"net/http"
 	"os"
+	"strconv"
+	"strings"
 	"syscall"
 
 	"golang.org/x/crypto/ssh/terminal"
@@ -16,6 +18,10 @@ import (
 	"github.com/cloudfoundry-community/cfseeker/seeker"
 )
 
+//noPrompts makes requests fail instead of prompting for basic auth credentials,
+// for when the user isn't there to answer, such as during shell completion
+var noPrompts bool
+
 // This function is meant to perform any request from the CLI to the API. This
 // function is used for the command dispatcher, and therefore it has to return a
 // function which is of the type commandFn. Different CLI commands can be
@@ -26,17 +32,32 @@ import (
 // returned. If a non-2xx code is returned from the API, then an error
 // containing the meta.error key in the JSON response will be returned.
 func cliRequest(cmdInfo func(interface{}) (string, string, seeker.Output)) commandFn {
-	return func(input interface{}) (seeker.Output, error) {
+	return cliBodyRequest(func(input interface{}) (string, string, []byte, seeker.Output) {
 		method, uri, output := cmdInfo(input)
+		return method, uri, nil, output
+	})
+}
+
+// cliBodyRequest works like cliRequest, except that cmdInfo also returns a
+// JSON body to send along with the HTTP request.
+func cliBodyRequest(cmdInfo func(interface{}) (string, string, []byte, seeker.Output)) commandFn {
+	return func(input interface{}) (seeker.Output, error) {
+		method, uri, body, output := cmdInfo(input)
 		if output == nil {
 			panic("cmdInfo gave back nil output interface")
 		}
-		req, err := http.NewRequest(method, uri, bytes.NewReader(nil))
+		req, err := http.NewRequest(method, uri, bytes.NewReader(body))
 		if err != nil {
 			panic(fmt.Sprintf("Couldn't create HTTP request from cmdInfo function: %s", err))
 		}
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
 		if usernameFlag != nil && *usernameFlag != "" {
 			if passwordFlag == nil || *passwordFlag == "" {
+				if noPrompts {
+					return nil, fmt.Errorf("No password given for basic auth")
+				}
 				password := promptForPassword()
 				passwordFlag = &password
 			}
@@ -49,15 +70,19 @@ func cliRequest(cmdInfo func(interface{}) (string, string, seeker.Output)) comma
 		}
 
 		if basicAuthRequested(resp) { //Do it again with some auth
+			if noPrompts {
+				return nil, fmt.Errorf("Basic auth required but cannot prompt for it")
+			}
 			username, password := promptForBasicAuth()
 			req.SetBasicAuth(username, password)
+			req.Body = ioutil.NopCloser(bytes.NewReader(body))
 			resp, err = http.DefaultClient.Do(req)
 			if err != nil {
 				return nil, fmt.Errorf("Error sending HTTP request")
 			}
 		}
 
-		body, err := ioutil.ReadAll(resp.Body)
+		respBody, err := ioutil.ReadAll(resp.Body)
 		if err != nil {
 			return nil, fmt.Errorf("Error reading response body: %s", err)
 		}
@@ -70,7 +95,7 @@ func cliRequest(cmdInfo func(interface{}) (string, string, seeker.Output)) comma
 
 		//Make the HTTP response into a struct we can use
 		apiResponse := api.Response{Contents: &mapOutput{}}
-		err = json.Unmarshal(body, &apiResponse)
+		err = json.Unmarshal(respBody, &apiResponse)
 		contentsJSON, err := json.Marshal(apiResponse.Contents)
 		if err != nil {
 			panic("Nil contents given to Marshal")
@@ -89,6 +114,10 @@ func cliRequest(cmdInfo func(interface{}) (string, string, seeker.Output)) comma
 			return nil, fmt.Errorf("Error given from API Request: %s", apiResponse.Meta.Error)
 		}
 
+		if apiResponse.Meta != nil && apiResponse.Meta.Warning != "" {
+			warn(apiResponse.Meta.Warning)
+		}
+
 		if outStruct, isNoOutput := output.(*noOutput); isNoOutput {
 			if apiResponse.Meta != nil {
 				outStruct.Message = apiResponse.Meta.Message
@@ -137,12 +166,42 @@ func promptForPassword() (password string) {
 func getCLIFn(command string) (toRun commandFn, toInput interface{}) {
 	switch command {
 	case "find":
+		if *fileFind != "" {
+			toRun = cliBodyRequest(findBatchCLICommand)
+			toInput = findBatchInput()
+			break
+		}
+		if *urlFind != "" {
+			toRun = cliRequest(findRouteCLICommand)
+			toInput = findRouteInput()
+			break
+		}
+		if findingScope() {
+			toRun = cliRequest(findScopeCLICommand)
+			toInput = commands.FindScopeInput{
+				OrgName:      *orgFind,
+				SpaceName:    *spaceFind,
+				AllInstances: *allFind,
+				Usage:        *usageFind,
+				Vitals:       *vitalsFind,
+			}
+			break
+		}
 		toRun = cliRequest(findCLICommand)
 		toInput = commands.FindInput{
-			AppGUID:   *appGUIDFind,
-			OrgName:   *orgFind,
-			SpaceName: *spaceFind,
-			AppName:   *appNameFind,
+			AppGUID:      *appGUIDFind,
+			OrgName:      *orgFind,
+			SpaceName:    *spaceFind,
+			AppName:      *appNameFind,
+			AllInstances: *allFind,
+			Usage:        *usageFind,
+			Vitals:       *vitalsFind,
+		}
+	case "locate":
+		toRun = cliRequest(locateCLICommand)
+		toInput = commands.LocateInput{
+			AppGUID: *appGUIDLocate,
+			AppName: *appNameLocate,
 		}
 	case "server":
 		bailWith("Refusing to run server mode with --target (-t) flag set")
@@ -153,10 +212,13 @@ func getCLIFn(command string) (toRun commandFn, toInput interface{}) {
 		toRun = cliRequest(infoCLICommand)
 		toInput = nil
 	case "convert guid":
-		toRun = cliRequest(convertCLICommand)
-		toInput = commands.ConvertInput{
-			GUID: *guidGUIDConv,
+		if *fileGUIDConv != "" {
+			toRun = cliBodyRequest(convertBatchCLICommand)
+			toInput = convertBatchInput()
+			break
 		}
+		toRun = cliRequest(convertCLICommand)
+		toInput = convertGUIDInput()
 	case "convert org":
 		toRun = cliRequest(convertCLICommand)
 		toInput = commands.ConvertInput{
@@ -175,6 +237,44 @@ func getCLIFn(command string) (toRun commandFn, toInput interface{}) {
 			SpaceName: *spaceNameAppConv,
 			AppName:   *appNameAppConv,
 		}
+	case "list":
+		toRun = cliRequest(listCLICommand)
+		toInput = commands.ListInput{
+			VMName:     *vmList,
+			Deployment: *deploymentList,
+		}
+	case "whois":
+		toRun = cliRequest(whoisCLICommand)
+		toInput = whoisInput(*addressWhois)
+	case "dependencies", "deps":
+		toRun = cliRequest(dependenciesCLICommand)
+		toInput = commands.DependenciesInput{
+			AppGUID:   *appGUIDDependencies,
+			OrgName:   *orgDependencies,
+			SpaceName: *spaceDependencies,
+			AppName:   *appNameDependencies,
+		}
+	case "find-service":
+		toRun = cliRequest(findServiceCLICommand)
+		toInput = commands.FindServiceInput{
+			ServiceGUID: *guidFindService,
+			OrgName:     *orgFindService,
+			SpaceName:   *spaceFindService,
+			ServiceName: *nameFindService,
+		}
+	case "search":
+		toRun = cliRequest(searchCLICommand)
+		toInput = commands.SearchInput{
+			Pattern: *patternSearch,
+		}
+	case "ha-check":
+		toRun = cliRequest(haCheckCLICommand)
+		toInput = commands.HACheckInput{
+			AppGUID:   *appGUIDHACheck,
+			OrgName:   *orgHACheck,
+			SpaceName: *spaceHACheck,
+			AppName:   *appNameHACheck,
+		}
 	default:
 		bailWith("Unrecognized command: %s", command)
 	}
@@ -185,19 +285,74 @@ func findCLICommand(input interface{}) (method, uri string, output seeker.Output
 	in := input.(commands.FindInput)
 
 	//Form the request uri
-	(*targetFlag).Path = api.FindEndpoint
+	(*targetFlag).Path = foundationPath(api.FindEndpoint)
 	query := (*targetFlag).Query()
 	query.Set(api.FindAppGUIDKey, in.AppGUID)
 	query.Set(api.FindOrgNameKey, in.OrgName)
 	query.Set(api.FindSpaceNameKey, in.SpaceName)
 	query.Set(api.FindAppNameKey, in.AppName)
+	query.Set(api.FindAllInstancesKey, strconv.FormatBool(in.AllInstances))
+	query.Set(api.FindUsageKey, strconv.FormatBool(in.Usage))
+	query.Set(api.FindVitalsKey, strconv.FormatBool(in.Vitals))
 	(*targetFlag).RawQuery = query.Encode()
 
 	return "GET", (*targetFlag).String(), &commands.FindOutput{}
 }
 
+func findRouteCLICommand(input interface{}) (method, uri string, output seeker.Output) {
+	in := input.(commands.FindRouteInput)
+
+	//Form the request uri
+	(*targetFlag).Path = foundationPath(api.FindEndpoint)
+	query := (*targetFlag).Query()
+	query.Set(api.FindRouteKey, in.URL)
+	query.Set(api.FindAllInstancesKey, strconv.FormatBool(in.AllInstances))
+	query.Set(api.FindUsageKey, strconv.FormatBool(in.Usage))
+	query.Set(api.FindVitalsKey, strconv.FormatBool(in.Vitals))
+	(*targetFlag).RawQuery = query.Encode()
+
+	return "GET", (*targetFlag).String(), &commands.FindRouteOutput{}
+}
+
+func findScopeCLICommand(input interface{}) (method, uri string, output seeker.Output) {
+	in := input.(commands.FindScopeInput)
+
+	//Form the request uri
+	(*targetFlag).Path = foundationPath(api.FindEndpoint)
+	query := (*targetFlag).Query()
+	query.Set(api.FindOrgNameKey, in.OrgName)
+	query.Set(api.FindSpaceNameKey, in.SpaceName)
+	query.Set(api.FindAllInstancesKey, strconv.FormatBool(in.AllInstances))
+	query.Set(api.FindUsageKey, strconv.FormatBool(in.Usage))
+	query.Set(api.FindVitalsKey, strconv.FormatBool(in.Vitals))
+	(*targetFlag).RawQuery = query.Encode()
+
+	return "GET", (*targetFlag).String(), &commands.FindScopeOutput{}
+}
+
+func findBatchCLICommand(input interface{}) (method, uri string, body []byte, output seeker.Output) {
+	in := input.(commands.FindBatchInput)
+
+	//Form the request uri
+	(*targetFlag).Path = foundationPath(api.FindBatchEndpoint)
+	query := (*targetFlag).Query()
+	query.Set(api.FindAllInstancesKey, strconv.FormatBool(in.AllInstances))
+	query.Set(api.FindUsageKey, strconv.FormatBool(in.Usage))
+	query.Set(api.FindVitalsKey, strconv.FormatBool(in.Vitals))
+	(*targetFlag).RawQuery = query.Encode()
+
+	body, err := json.Marshal(in.Apps)
+	if err != nil {
+		panic(fmt.Sprintf("Could not marshal apps to find: %s", err))
+	}
+	return "POST", (*targetFlag).String(), body, &commands.FindBatchOutput{}
+}
+
 func invalidateCLICommand(input interface{}) (method, uri string, output seeker.Output) {
-	(*targetFlag).Path = api.InvalidateBOSHEndpoint
+	(*targetFlag).Path = foundationPath(api.InvalidateBOSHEndpoint)
+	if *cfInvalidate {
+		(*targetFlag).Path = foundationPath(api.InvalidateCFEndpoint)
+	}
 	return "DELETE", (*targetFlag).String(), &noOutput{}
 }
 
@@ -210,7 +365,7 @@ func convertCLICommand(input interface{}) (method, uri string, output seeker.Out
 	in := input.(commands.ConvertInput)
 
 	//Form the request uri
-	(*targetFlag).Path = api.ConvertEndpoint
+	(*targetFlag).Path = foundationPath(api.ConvertEndpoint)
 	query := (*targetFlag).Query()
 	query.Set(api.ConvertGUIDKey, in.GUID)
 	query.Set(api.ConvertOrgNameKey, in.OrgName)
@@ -220,6 +375,139 @@ func convertCLICommand(input interface{}) (method, uri string, output seeker.Out
 	return "GET", (*targetFlag).String(), &commands.ConvertOutput{}
 }
 
+func convertBatchCLICommand(input interface{}) (method, uri string, body []byte, output seeker.Output) {
+	in := input.(commands.ConvertBatchInput)
+
+	(*targetFlag).Path = foundationPath(api.ConvertBatchEndpoint)
+	body, err := json.Marshal(in.GUIDs)
+	if err != nil {
+		panic(fmt.Sprintf("Could not marshal GUIDs to convert: %s", err))
+	}
+	return "POST", (*targetFlag).String(), body, &commands.ConvertBatchOutput{}
+}
+
+func listCLICommand(input interface{}) (method, uri string, output seeker.Output) {
+	in := input.(commands.ListInput)
+
+	job, index, err := commands.ParseVMName(in.VMName)
+	if err != nil {
+		bailWith(err.Error())
+	}
+
+	//Form the request uri
+	endpoint := api.ListAnyDeploymentEndpoint
+	if in.Deployment != "" {
+		endpoint = api.ListEndpoint
+	}
+	(*targetFlag).Path = strings.NewReplacer(
+		"{deployment}", in.Deployment,
+		"{job}", job,
+		"{index}", strconv.Itoa(index),
+	).Replace(foundationPath(endpoint))
+	return "GET", (*targetFlag).String(), &commands.ListOutput{}
+}
+
+func whoisCLICommand(input interface{}) (method, uri string, output seeker.Output) {
+	in := input.(commands.WhoisInput)
+
+	//Form the request uri
+	(*targetFlag).Path = foundationPath(api.WhoisEndpoint)
+	query := (*targetFlag).Query()
+	query.Set(api.WhoisHostKey, in.Host)
+	query.Set(api.WhoisPortKey, in.Port)
+	query.Set(api.WhoisContainerIPKey, in.ContainerIP)
+	(*targetFlag).RawQuery = query.Encode()
+	return "GET", (*targetFlag).String(), &commands.WhoisOutput{}
+}
+
+func dependenciesCLICommand(input interface{}) (method, uri string, output seeker.Output) {
+	in := input.(commands.DependenciesInput)
+
+	//Form the request uri
+	(*targetFlag).Path = foundationPath(api.DependenciesEndpoint)
+	query := (*targetFlag).Query()
+	query.Set(api.DependenciesAppGUIDKey, in.AppGUID)
+	query.Set(api.DependenciesOrgNameKey, in.OrgName)
+	query.Set(api.DependenciesSpaceNameKey, in.SpaceName)
+	query.Set(api.DependenciesAppNameKey, in.AppName)
+	(*targetFlag).RawQuery = query.Encode()
+	return "GET", (*targetFlag).String(), &commands.DependenciesOutput{}
+}
+
+func findServiceCLICommand(input interface{}) (method, uri string, output seeker.Output) {
+	in := input.(commands.FindServiceInput)
+
+	//Form the request uri
+	(*targetFlag).Path = foundationPath(api.FindServiceEndpoint)
+	query := (*targetFlag).Query()
+	query.Set(api.FindServiceGUIDKey, in.ServiceGUID)
+	query.Set(api.FindServiceOrgNameKey, in.OrgName)
+	query.Set(api.FindServiceSpaceNameKey, in.SpaceName)
+	query.Set(api.FindServiceNameKey, in.ServiceName)
+	(*targetFlag).RawQuery = query.Encode()
+	return "GET", (*targetFlag).String(), &commands.FindServiceOutput{}
+}
+
+func searchCLICommand(input interface{}) (method, uri string, output seeker.Output) {
+	in := input.(commands.SearchInput)
+
+	//Form the request uri
+	(*targetFlag).Path = foundationPath(api.SearchEndpoint)
+	query := (*targetFlag).Query()
+	query.Set(api.SearchPatternKey, in.Pattern)
+	(*targetFlag).RawQuery = query.Encode()
+	return "GET", (*targetFlag).String(), &commands.SearchOutput{}
+}
+
+func completeCLICommand(input interface{}) (method, uri string, output seeker.Output) {
+	in := input.(commands.CompleteInput)
+
+	//Form the request uri
+	(*targetFlag).Path = foundationPath(api.CompleteEndpoint)
+	query := (*targetFlag).Query()
+	query.Set(api.CompleteTypeKey, in.Type)
+	query.Set(api.CompleteOrgNameKey, in.OrgName)
+	query.Set(api.CompleteSpaceNameKey, in.SpaceName)
+	query.Set(api.CompletePrefixKey, in.Prefix)
+	(*targetFlag).RawQuery = query.Encode()
+	return "GET", (*targetFlag).String(), &commands.CompleteOutput{}
+}
+
+func haCheckCLICommand(input interface{}) (method, uri string, output seeker.Output) {
+	in := input.(commands.HACheckInput)
+
+	//Form the request uri
+	(*targetFlag).Path = foundationPath(api.HACheckEndpoint)
+	query := (*targetFlag).Query()
+	query.Set(api.HACheckAppGUIDKey, in.AppGUID)
+	query.Set(api.HACheckOrgNameKey, in.OrgName)
+	query.Set(api.HACheckSpaceNameKey, in.SpaceName)
+	query.Set(api.HACheckAppNameKey, in.AppName)
+	(*targetFlag).RawQuery = query.Encode()
+	return "GET", (*targetFlag).String(), &commands.HACheckOutput{}
+}
+
+func locateCLICommand(input interface{}) (method, uri string, output seeker.Output) {
+	in := input.(commands.LocateInput)
+
+	//Form the request uri
+	(*targetFlag).Path = api.LocateEndpoint
+	query := (*targetFlag).Query()
+	query.Set(api.LocateAppGUIDKey, in.AppGUID)
+	query.Set(api.LocateAppNameKey, in.AppName)
+	(*targetFlag).RawQuery = query.Encode()
+	return "GET", (*targetFlag).String(), &commands.LocateOutput{}
+}
+
+//foundationPath returns the path of the given endpoint for the foundation given
+// with --foundation, or the endpoint itself if no foundation was given
+func foundationPath(endpoint string) string {
+	if *foundationFlag == "" {
+		return endpoint
+	}
+	return strings.Replace(api.InFoundation(endpoint), "{"+api.FoundationKey+"}", *foundationFlag, 1)
+}
+
 type noOutput struct {
 	Message string `json:"message,omitempty"`
 }
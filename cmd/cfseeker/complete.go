@@ -0,0 +1,54 @@
+package main
+
+import (
+	"github.com/cloudfoundry-community/cfseeker/commands"
+
+	kingpin "gopkg.in/alecthomas/kingpin.v2"
+)
+
+//orgHints completes org names for the shell
+func orgHints() []string {
+	return completeNames(commands.CompleteInput{Type: commands.CompleteTypeOrg})
+}
+
+//spaceHints completes the names of the spaces in the org given to the flag that
+// org points at
+func spaceHints(org *string) kingpin.HintAction {
+	return func() []string {
+		return completeNames(commands.CompleteInput{Type: commands.CompleteTypeSpace, OrgName: *org})
+	}
+}
+
+//appHints completes the names of the apps in the org and space given to the
+// flags that org and space point at
+func appHints(org, space *string) kingpin.HintAction {
+	return func() []string {
+		return completeNames(commands.CompleteInput{Type: commands.CompleteTypeApp, OrgName: *org, SpaceName: *space})
+	}
+}
+
+//completeNames looks up the names for shell completion, from the server if
+// --target is given and otherwise from the configured foundation. The shell
+// narrows the names down to what has been typed. Nothing is completed if the
+// names can't be looked up, and the user is never prompted for anything.
+func completeNames(in commands.CompleteInput) []string {
+	setupLogging()
+	if (in.Type != commands.CompleteTypeOrg && in.OrgName == "") ||
+		(in.Type == commands.CompleteTypeApp && in.SpaceName == "") {
+		return nil
+	}
+
+	var toRun commandFn
+	if targetIsSet() {
+		noPrompts = true
+		toRun = cliRequest(completeCLICommand)
+	} else {
+		toRun = completeCommand
+	}
+
+	output, err := toRun(in)
+	if err != nil {
+		return nil
+	}
+	return output.(*commands.CompleteOutput).Names
+}
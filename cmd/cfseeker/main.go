@@ -1,11 +1,16 @@
 package main
 
 import (
+	"bufio"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"net"
 	"os"
+	"strings"
 
+	"github.com/cloudfoundry-community/cfseeker/commands"
 	"github.com/cloudfoundry-community/cfseeker/config"
 	"github.com/cloudfoundry-community/cfseeker/seeker"
 	"github.com/starkandwayne/goutils/ansi"
@@ -18,37 +23,45 @@ import (
 var (
 	cmdLine = kingpin.New("cfseeker", "Do you know where your CF apps are?").Version(config.Version)
 	//Global flags
-	configPath   = cmdLine.Flag("config", "Path to a config file to load").Short('c').Default("./seekerconf.yml").Envar("SEEKERCONF").String()
-	debugFlag    = cmdLine.Flag("debug", "Turn debug output on").Short('d').Bool()
-	jsonFlag     = cmdLine.Flag("json", "Give output in JSON instead of YAML").Short('j').Bool()
-	targetFlag   = cmdLine.Flag("target", "URL to target in CLI mode").Short('t').URL()
-	usernameFlag = cmdLine.Flag("username", "Username for basic auth in CLI mode").Short('u').String()
-	passwordFlag = cmdLine.Flag("password", "Password for basic auth in CLI mode. Will prompt if not given").Short('p').String()
+	configPath     = cmdLine.Flag("config", "Path to a config file to load").Short('c').Default("./seekerconf.yml").Envar("SEEKERCONF").String()
+	debugFlag      = cmdLine.Flag("debug", "Turn debug output on").Short('d').Bool()
+	jsonFlag       = cmdLine.Flag("json", "Give output in JSON instead of YAML").Short('j').Bool()
+	targetFlag     = cmdLine.Flag("target", "URL to target in CLI mode").Short('t').URL()
+	usernameFlag   = cmdLine.Flag("username", "Username for basic auth in CLI mode").Short('u').String()
+	passwordFlag   = cmdLine.Flag("password", "Password for basic auth in CLI mode. Will prompt if not given").Short('p').String()
+	foundationFlag = cmdLine.Flag("foundation", "Name of the configured foundation to run the command against. Uses the default foundation if not given").Short('F').String()
+	wideFlag       = cmdLine.Flag("wide", "Also show the BOSH instance ID, VM CID, agent ID, VM type, resource pool, job state, and bootstrap flag of each instance").Short('w').Bool()
 
 	//FIND
-	findCom     = cmdLine.Command("find", "Get the location of an app")
-	orgFind     = findCom.Flag("org", "The organization where the app is pushed").Short('o').String()
-	spaceFind   = findCom.Flag("space", "The space within the given org where the app is pushed").Short('s').String()
-	appNameFind = findCom.Flag("app", "The name of the app to look up").Short('a').String()
+	findCom     = cmdLine.Command("find", "Get the location of an app, or of every app in an org or space")
+	orgFind     = findCom.Flag("org", "The organization where the app is pushed").Short('o').HintAction(orgHints).String()
+	spaceFind   = findCom.Flag("space", "The space within the given org where the app is pushed").Short('s').HintAction(spaceHints(orgFind)).String()
+	appNameFind = findCom.Flag("app", "The name of the app to look up").Short('a').HintAction(appHints(orgFind, spaceFind)).String()
 	appGUIDFind = findCom.Flag("app-guid", "The GUID assigned to the app to look up").Short('g').String()
+	allFind     = findCom.Flag("all-instances", "Also list instances that aren't running").Short('A').Bool()
+	usageFind   = findCom.Flag("usage", "Include the resource usage, quotas, uptime, and URIs of each running instance").Bool()
+	vitalsFind  = findCom.Flag("vitals", "Include the vitals of the BOSH VM each running instance is on, highlighting VMs at or above the configured thresholds").Bool()
+	urlFind     = findCom.Flag("url", "Find the apps mapped to the route this URL points to instead").Short('U').String()
+	fileFind    = findCom.Flag("from-file", "Find each app listed in this file, one GUID or <org>/<space>/<app> per line. Give --from-file=- to read from stdin").Short('f').String()
 
 	//CONVERT
 	convCom = cmdLine.Command("convert", "Convert from GUID to name")
 
 	guidConvCom  = convCom.Command("guid", "Convert a GUID to the information it points to")
-	guidGUIDConv = guidConvCom.Flag("guid", "GUID to get a name for").Short('g').Required().String()
+	guidGUIDConv = guidConvCom.Flag("guid", "GUID to get a name for").Short('g').String()
+	fileGUIDConv = guidConvCom.Flag("from-file", "Convert each GUID listed in this file, one per line. Give --from-file=- to read from stdin").Short('f').String()
 
 	orgConvCom     = convCom.Command("org", "Convert an org name to its GUID")
-	orgNameOrgConv = orgConvCom.Flag("org", "Name of the org").Short('o').Required().String()
+	orgNameOrgConv = orgConvCom.Flag("org", "Name of the org").Short('o').HintAction(orgHints).Required().String()
 
 	spaceConvCom       = convCom.Command("space", "Convert org and space names to its org and space GUIDs")
-	orgNameSpaceConv   = spaceConvCom.Flag("org", "Name of the org").Short('o').Required().String()
-	spaceNameSpaceConv = spaceConvCom.Flag("space", "Name of the space").Short('s').Required().String()
+	orgNameSpaceConv   = spaceConvCom.Flag("org", "Name of the org").Short('o').HintAction(orgHints).Required().String()
+	spaceNameSpaceConv = spaceConvCom.Flag("space", "Name of the space").Short('s').HintAction(spaceHints(orgNameSpaceConv)).Required().String()
 
 	appConvCom       = convCom.Command("app", "Convert org, space, and app names to their respective GUID information")
-	orgNameAppConv   = appConvCom.Flag("org", "Name of the org").Short('o').Required().String()
-	spaceNameAppConv = appConvCom.Flag("space", "Name of the space").Short('s').Required().String()
-	appNameAppConv   = appConvCom.Flag("app", "Name of the app").Short('a').Required().String()
+	orgNameAppConv   = appConvCom.Flag("org", "Name of the org").Short('o').HintAction(orgHints).Required().String()
+	spaceNameAppConv = appConvCom.Flag("space", "Name of the space").Short('s').HintAction(spaceHints(orgNameAppConv)).Required().String()
+	appNameAppConv   = appConvCom.Flag("app", "Name of the app").Short('a').HintAction(appHints(orgNameAppConv, spaceNameAppConv)).Required().String()
 
 	//SERVER
 	serverCom    = cmdLine.Command("server", "Run cfseeker in server mode")
@@ -56,18 +69,65 @@ var (
 
 	//INVALIDATE
 	invalidateCom = cmdLine.Command("invalidate", "Invalidate the BOSH cache on a cfseeker server")
+	cfInvalidate  = invalidateCom.Flag("cf", "Invalidate the Cloud Controller name and GUID cache instead").Bool()
 
 	//INFO
 	infoCom = cmdLine.Command("info", "Gives info about a running cfseeker server").Alias("meta")
 
-	// //LIST
-	// listCom = cmdLine.Command("list", "List all the apps on a given BOSH VM")
-	// vmList  = listCom.Flag("vm", "The vm name to list instances for (<jobname>/<index>)").Required().String()
+	//LIST
+	listCom        = cmdLine.Command("list", "List all the apps on a given BOSH VM")
+	vmList         = listCom.Flag("vm", "The vm name to list instances for (<jobname>/<index>)").Required().String()
+	deploymentList = listCom.Flag("deployment", "The BOSH deployment the VM is in. Searches all configured deployments if not given").Short('D').String()
+
+	//WHOIS
+	whoisCom         = cmdLine.Command("whois", "Find the app instance listening on a given backend address, or with a given container IP")
+	addressWhois     = whoisCom.Arg("address", "The backend address to look up (<ip>:<port>)").String()
+	containerIPWhois = whoisCom.Flag("container-ip", "Look up the app instance whose container has this overlay IP instead. Requires the v3 CF backend").String()
+
+	//HA-CHECK
+	haCheckCom     = cmdLine.Command("ha-check", "Check how an app, or every app in an org or space, is spread across cells and AZs")
+	orgHACheck     = haCheckCom.Flag("org", "The organization to check, or where the app is pushed").Short('o').HintAction(orgHints).String()
+	spaceHACheck   = haCheckCom.Flag("space", "The space within the given org to check, or where the app is pushed").Short('s').HintAction(spaceHints(orgHACheck)).String()
+	appNameHACheck = haCheckCom.Flag("app", "The name of the app to check").Short('a').HintAction(appHints(orgHACheck, spaceHACheck)).String()
+	appGUIDHACheck = haCheckCom.Flag("app-guid", "The GUID assigned to the app to check").Short('g').String()
+
+	//DEPENDENCIES
+	dependenciesCom     = cmdLine.Command("dependencies", "Show the BOSH VMs an app runs on, and the BOSH VMs that its service bindings point it at").Alias("deps")
+	orgDependencies     = dependenciesCom.Flag("org", "The organization where the app is pushed").Short('o').HintAction(orgHints).String()
+	spaceDependencies   = dependenciesCom.Flag("space", "The space within the given org where the app is pushed").Short('s').HintAction(spaceHints(orgDependencies)).String()
+	appNameDependencies = dependenciesCom.Flag("app", "The name of the app to look up").Short('a').HintAction(appHints(orgDependencies, spaceDependencies)).String()
+	appGUIDDependencies = dependenciesCom.Flag("app-guid", "The GUID assigned to the app to look up").Short('g').String()
+
+	//FIND-SERVICE
+	findServiceCom   = cmdLine.Command("find-service", "Get the BOSH VMs backing an on-demand service instance, and the location of the apps bound to it")
+	orgFindService   = findServiceCom.Flag("org", "The organization where the service instance is").Short('o').HintAction(orgHints).String()
+	spaceFindService = findServiceCom.Flag("space", "The space within the given org where the service instance is").Short('s').HintAction(spaceHints(orgFindService)).String()
+	nameFindService  = findServiceCom.Flag("name", "The name of the service instance to look up").Short('n').String()
+	guidFindService  = findServiceCom.Flag("guid", "The GUID of the service instance to look up").Short('g').String()
+
+	//SEARCH
+	searchCom     = cmdLine.Command("search", "Find apps in any org or space whose names match a pattern")
+	patternSearch = searchCom.Arg("pattern", "A glob (using *, ?, or [...]) matching the whole app name, or else text the name contains. Case is ignored").Required().String()
+
+	//LOCATE
+	locateCom     = cmdLine.Command("locate", "Search every configured foundation for an app")
+	appNameLocate = locateCom.Flag("app", "The name of the app to search for").Short('a').String()
+	appGUIDLocate = locateCom.Flag("app-guid", "The GUID of the app to search for").Short('g').String()
+
+	//conf is the config for the foundation that commands are run against
 	conf *config.Config
+	//allConf is the config as it was read, with every foundation in it
+	allConf *config.Config
 )
 
 type commandFn func(inputs interface{}) (seeker.Output, error)
 
+//narrower is implemented by command outputs which have fields that are only
+// shown with --wide
+type narrower interface {
+	Narrow()
+}
+
 func main() {
 	cmdLine.HelpFlag.Short('h')
 	cmdLine.VersionFlag.Short('v')
@@ -75,11 +135,16 @@ func main() {
 	command := kingpin.MustParse(cmdLine.Parse(os.Args[1:]))
 
 	var err error
-	conf, err = initializeConfig()
+	allConf, err = initializeConfig()
 	if err != nil {
 		bailWith(err.Error())
 	}
 
+	conf, err = allConf.Foundation(*foundationFlag)
+	if err != nil && !targetIsSet() {
+		bailWith(err.Error())
+	}
+
 	setupLogging()
 
 	var toRun commandFn
@@ -100,6 +165,16 @@ func main() {
 
 	log.Debugf("Done with user command")
 
+	if w, isWarner := cmdOut.(commands.Warner); isWarner {
+		for _, warning := range w.Warnings() {
+			warn(warning)
+		}
+	}
+
+	if n, isNarrower := cmdOut.(narrower); isNarrower && !*wideFlag {
+		n.Narrow()
+	}
+
 	var userOutput []byte
 
 	if *jsonFlag {
@@ -114,9 +189,19 @@ func main() {
 	fmt.Println(string(userOutput))
 }
 
+//warn tells the user about a problem which didn't stop their command from
+// succeeding
+func warn(message string) {
+	ansi.Fprintf(os.Stderr, "@Y{Warning: %s}\n", message)
+}
+
 func initializeConfig() (*config.Config, error) {
 	ansi.Fprintf(os.Stderr, "@G{Using config path: %s}\n", *configPath)
+	return loadConfig()
+}
 
+//loadConfig reads the config file given with --config, filling in defaults
+func loadConfig() (*config.Config, error) {
 	configFile, err := os.Open(*configPath)
 	if err != nil {
 		return nil, fmt.Errorf("Error opening config file: %s", err.Error())
@@ -129,16 +214,147 @@ func initializeConfig() (*config.Config, error) {
 
 	var ret config.Config
 	//Set defaults
-	ret.Server.CacheTTL = 60 * 15 //15 Minutes
-	ret.HTTPTimeout = 15          //15 seconds
+	ret.Server.CacheTTL = 60 * 15         //15 Minutes
+	ret.Server.ListingTTL = 60            //1 Minute
+	ret.Server.CFCacheTTL = 60 * 5        //5 Minutes
+	ret.Server.CacheSaveInterval = 60 * 5 //5 Minutes
+	ret.Server.IndexTTL = 60              //1 Minute
+	ret.HTTPTimeout = 15                  //15 seconds
+	ret.Vitals.CPUPercent = 90
+	ret.Vitals.MemPercent = 90
+	ret.Vitals.DiskPercent = 90
 	err = yaml.Unmarshal(configBytes, &ret)
 	if err != nil {
 		return nil, fmt.Errorf("Error while parsing config YAML: %s", err.Error())
 	}
 
+	err = ret.ValidateFoundations()
+	if err != nil {
+		return nil, err
+	}
+
 	return &ret, nil
 }
 
+//whoisInput splits the address given on the command line into the host and port
+// to look up, or gives the container IP to look up if --container-ip was given.
+func whoisInput(address string) commands.WhoisInput {
+	if *containerIPWhois != "" {
+		if address != "" {
+			bailWith("An address cannot be given along with --container-ip")
+		}
+		return commands.WhoisInput{ContainerIP: *containerIPWhois}
+	}
+	if address == "" {
+		bailWith("Either an address or --container-ip must be given")
+	}
+
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		bailWith("Could not parse address `%s`: %s", address, err)
+	}
+	return commands.WhoisInput{Host: host, Port: port}
+}
+
+//findRouteInput makes the input for finding apps by route, refusing to go on if
+// the app was also named with the other find flags.
+func findRouteInput() commands.FindRouteInput {
+	if *orgFind != "" || *spaceFind != "" || *appNameFind != "" || *appGUIDFind != "" {
+		bailWith("--url cannot be given along with an app name or GUID")
+	}
+	return commands.FindRouteInput{URL: *urlFind, AllInstances: *allFind, Usage: *usageFind, Vitals: *vitalsFind}
+}
+
+//findBatchInput reads the apps to find from the file given with --from-file,
+// or from stdin if the file is given as -. Each line holds either an app GUID
+// or the org, space, and app names separated by slashes. Blank lines and lines
+// starting with # are skipped.
+func findBatchInput() commands.FindBatchInput {
+	if *orgFind != "" || *spaceFind != "" || *appNameFind != "" || *appGUIDFind != "" || *urlFind != "" {
+		bailWith("--from-file cannot be given along with an app name, GUID, or URL")
+	}
+
+	var file io.Reader = os.Stdin
+	if *fileFind != "-" {
+		f, err := os.Open(*fileFind)
+		if err != nil {
+			bailWith("Could not open file `%s`: %s", *fileFind, err)
+		}
+		defer f.Close()
+		file = f
+	}
+
+	ret := commands.FindBatchInput{AllInstances: *allFind, Usage: *usageFind, Vitals: *vitalsFind}
+	scanner := bufio.NewScanner(file)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		parts := strings.Split(line, "/")
+		switch len(parts) {
+		case 1:
+			ret.Apps = append(ret.Apps, commands.FindInput{AppGUID: line})
+		case 3:
+			ret.Apps = append(ret.Apps, commands.FindInput{OrgName: parts[0], SpaceName: parts[1], AppName: parts[2]})
+		default:
+			bailWith("Line %d is neither an app GUID nor of the form <org>/<space>/<app>: %s", lineNo, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		bailWith("Error while reading apps to find: %s", err)
+	}
+	return ret
+}
+
+//convertBatchInput reads the GUIDs to convert from the file given with
+// --from-file, or from stdin if the file is given as -. Each line holds one
+// GUID. Blank lines and lines starting with # are skipped.
+func convertBatchInput() commands.ConvertBatchInput {
+	if *guidGUIDConv != "" {
+		bailWith("--from-file cannot be given along with --guid")
+	}
+
+	var file io.Reader = os.Stdin
+	if *fileGUIDConv != "-" {
+		f, err := os.Open(*fileGUIDConv)
+		if err != nil {
+			bailWith("Could not open file `%s`: %s", *fileGUIDConv, err)
+		}
+		defer f.Close()
+		file = f
+	}
+
+	ret := commands.ConvertBatchInput{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		ret.GUIDs = append(ret.GUIDs, line)
+	}
+	if err := scanner.Err(); err != nil {
+		bailWith("Error while reading GUIDs to convert: %s", err)
+	}
+	return ret
+}
+
+//convertGUIDInput returns the GUID to convert, bailing if none was given
+func convertGUIDInput() commands.ConvertInput {
+	if *guidGUIDConv == "" {
+		bailWith("Either --guid or --from-file must be given")
+	}
+	return commands.ConvertInput{GUID: *guidGUIDConv}
+}
+
+//findingScope returns true if the find flags name an org, and maybe a space,
+// but no app, meaning every app in that org or space should be found.
+func findingScope() bool {
+	return *orgFind != "" && *appNameFind == "" && *appGUIDFind == ""
+}
+
 func targetIsSet() bool {
 	return targetFlag != nil && *targetFlag != nil
 }
@@ -9,30 +9,64 @@ import (
 	"github.com/cloudfoundry-community/cfseeker/commands"
 	"github.com/cloudfoundry-community/cfseeker/config"
 	"github.com/cloudfoundry-community/cfseeker/seeker"
+	"github.com/starkandwayne/goutils/log"
 )
 
 func getStandaloneFn(command string) (toRun commandFn, toInput interface{}) {
 	switch command {
 	case "find":
+		if *fileFind != "" {
+			toRun = findBatchCommand
+			toInput = findBatchInput()
+			break
+		}
+		if *urlFind != "" {
+			toRun = findRouteCommand
+			toInput = findRouteInput()
+			break
+		}
+		if findingScope() {
+			toRun = findScopeCommand
+			toInput = commands.FindScopeInput{
+				OrgName:      *orgFind,
+				SpaceName:    *spaceFind,
+				AllInstances: *allFind,
+				Usage:        *usageFind,
+				Vitals:       *vitalsFind,
+			}
+			break
+		}
 		toRun = findCommand
 		toInput = commands.FindInput{
-			AppGUID:   *appGUIDFind,
-			OrgName:   *orgFind,
-			SpaceName: *spaceFind,
-			AppName:   *appNameFind,
+			AppGUID:      *appGUIDFind,
+			OrgName:      *orgFind,
+			SpaceName:    *spaceFind,
+			AppName:      *appNameFind,
+			AllInstances: *allFind,
+			Usage:        *usageFind,
+			Vitals:       *vitalsFind,
+		}
+	case "locate":
+		toRun = locateCommand
+		toInput = commands.LocateInput{
+			AppGUID: *appGUIDLocate,
+			AppName: *appNameLocate,
 		}
 	case "server":
 		toRun = serverCommand
-		toInput = serverInput{conf: conf}
+		toInput = serverInput{conf: allConf}
 	case "invalidate":
 		bailWith("Cannot run invalidate command without --target (-t) set")
 	case "info", "meta":
 		bailWith("Cannot run info command without --target (-t) set")
 	case "convert guid":
-		toRun = convertCommand
-		toInput = commands.ConvertInput{
-			GUID: *guidGUIDConv,
+		if *fileGUIDConv != "" {
+			toRun = convertBatchCommand
+			toInput = convertBatchInput()
+			break
 		}
+		toRun = convertCommand
+		toInput = convertGUIDInput()
 	case "convert org":
 		toRun = convertCommand
 		toInput = commands.ConvertInput{
@@ -51,6 +85,44 @@ func getStandaloneFn(command string) (toRun commandFn, toInput interface{}) {
 			SpaceName: *spaceNameAppConv,
 			AppName:   *appNameAppConv,
 		}
+	case "list":
+		toRun = listCommand
+		toInput = commands.ListInput{
+			VMName:     *vmList,
+			Deployment: *deploymentList,
+		}
+	case "whois":
+		toRun = whoisCommand
+		toInput = whoisInput(*addressWhois)
+	case "dependencies", "deps":
+		toRun = dependenciesCommand
+		toInput = commands.DependenciesInput{
+			AppGUID:   *appGUIDDependencies,
+			OrgName:   *orgDependencies,
+			SpaceName: *spaceDependencies,
+			AppName:   *appNameDependencies,
+		}
+	case "find-service":
+		toRun = findServiceCommand
+		toInput = commands.FindServiceInput{
+			ServiceGUID: *guidFindService,
+			OrgName:     *orgFindService,
+			SpaceName:   *spaceFindService,
+			ServiceName: *nameFindService,
+		}
+	case "search":
+		toRun = searchCommand
+		toInput = commands.SearchInput{
+			Pattern: *patternSearch,
+		}
+	case "ha-check":
+		toRun = haCheckCommand
+		toInput = commands.HACheckInput{
+			AppGUID:   *appGUIDHACheck,
+			OrgName:   *orgHACheck,
+			SpaceName: *spaceHACheck,
+			AppName:   *appNameHACheck,
+		}
 	default:
 		bailWith("Unrecognized command: %s", command)
 	}
@@ -66,6 +138,33 @@ func findCommand(input interface{}) (seeker.Output, error) {
 	return commands.Find(s, in)
 }
 
+func findBatchCommand(input interface{}) (seeker.Output, error) {
+	in := input.(commands.FindBatchInput)
+	s, err := seeker.NewSeeker(conf)
+	if err != nil {
+		return nil, err
+	}
+	return commands.FindBatch(s, in)
+}
+
+func findRouteCommand(input interface{}) (seeker.Output, error) {
+	in := input.(commands.FindRouteInput)
+	s, err := seeker.NewSeeker(conf)
+	if err != nil {
+		return nil, err
+	}
+	return commands.FindRoute(s, in)
+}
+
+func findScopeCommand(input interface{}) (seeker.Output, error) {
+	in := input.(commands.FindScopeInput)
+	s, err := seeker.NewSeeker(conf)
+	if err != nil {
+		return nil, err
+	}
+	return commands.FindScope(s, in)
+}
+
 type serverInput struct {
 	conf *config.Config
 }
@@ -80,7 +179,10 @@ func serverCommand(input interface{}) (seeker.Output, error) {
 			return nil, fmt.Errorf("PORT environment variable cannot be converted to int")
 		}
 	}
-	err = api.Initialize(in.conf) //Never exits without an error
+	err = api.Initialize(in.conf) //Only exits without an error once shut down
+	if err == nil {
+		os.Exit(0)
+	}
 	return nil, err
 }
 
@@ -94,3 +196,108 @@ func convertCommand(input interface{}) (seeker.Output, error) {
 	}
 	return commands.Convert(s, in)
 }
+
+func convertBatchCommand(input interface{}) (seeker.Output, error) {
+	in := input.(commands.ConvertBatchInput)
+	conf.SkipBOSH()
+	s, err := seeker.NewSeeker(conf)
+	if err != nil {
+		return nil, err
+	}
+	return commands.ConvertBatch(s, in)
+}
+
+func listCommand(input interface{}) (seeker.Output, error) {
+	in := input.(commands.ListInput)
+	s, err := seeker.NewSeeker(conf)
+	if err != nil {
+		return nil, err
+	}
+	return commands.List(s, in)
+}
+
+func whoisCommand(input interface{}) (seeker.Output, error) {
+	in := input.(commands.WhoisInput)
+	s, err := seeker.NewSeeker(conf)
+	if err != nil {
+		return nil, err
+	}
+	return commands.Whois(s, in)
+}
+
+func dependenciesCommand(input interface{}) (seeker.Output, error) {
+	in := input.(commands.DependenciesInput)
+	s, err := seeker.NewSeeker(conf)
+	if err != nil {
+		return nil, err
+	}
+	return commands.Dependencies(s, in)
+}
+
+func findServiceCommand(input interface{}) (seeker.Output, error) {
+	in := input.(commands.FindServiceInput)
+	s, err := seeker.NewSeeker(conf)
+	if err != nil {
+		return nil, err
+	}
+	return commands.FindService(s, in)
+}
+
+func searchCommand(input interface{}) (seeker.Output, error) {
+	in := input.(commands.SearchInput)
+	s, err := seeker.NewSeeker(conf)
+	if err != nil {
+		return nil, err
+	}
+	return commands.Search(s, in)
+}
+
+//completeCommand loads the config itself, since shell completion happens
+// before main does
+func completeCommand(input interface{}) (seeker.Output, error) {
+	in := input.(commands.CompleteInput)
+	allConf, err := loadConfig()
+	if err != nil {
+		return nil, err
+	}
+	conf, err := allConf.Foundation(*foundationFlag)
+	if err != nil {
+		return nil, err
+	}
+	//Completing names only needs CF, and shouldn't wait on BOSH directors
+	conf.SkipBOSH()
+	s, err := seeker.NewSeeker(conf)
+	if err != nil {
+		return nil, err
+	}
+	return commands.Complete(s, in)
+}
+
+func haCheckCommand(input interface{}) (seeker.Output, error) {
+	in := input.(commands.HACheckInput)
+	s, err := seeker.NewSeeker(conf)
+	if err != nil {
+		return nil, err
+	}
+	return commands.HACheck(s, in)
+}
+
+//locateCommand makes a seeker for every configured foundation, rather than
+// just the one given with --foundation. Foundations which can't be connected to
+// are reported as errors in the output instead of stopping the search.
+func locateCommand(input interface{}) (seeker.Output, error) {
+	in := input.(commands.LocateInput)
+	in.Foundations = allConf.FoundationNames()
+	seekers := map[string]*seeker.Seeker{}
+	for _, name := range in.Foundations {
+		foundationConf, err := allConf.Foundation(name)
+		if err != nil {
+			return nil, err
+		}
+		seekers[name], err = seeker.NewSeeker(foundationConf)
+		if err != nil {
+			log.Warnf("Could not set up foundation `%s`: %s", name, err.Error())
+		}
+	}
+	return commands.Locate(seekers, in)
+}
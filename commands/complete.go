@@ -0,0 +1,79 @@
+package commands
+
+import (
+	"encoding/json"
+
+	"github.com/cloudfoundry-community/cfseeker/seeker"
+	"github.com/starkandwayne/goutils/log"
+)
+
+const (
+	//CompleteTypeOrg completes org names
+	CompleteTypeOrg = "org"
+	//CompleteTypeSpace completes the names of spaces in an org
+	CompleteTypeSpace = "space"
+	//CompleteTypeApp completes the names of apps in a space
+	CompleteTypeApp = "app"
+)
+
+//CompleteInput contains the information required to complete a name
+type CompleteInput struct {
+	//Type is what kind of name to complete. One of the CompleteType constants.
+	Type string
+	//OrgName is required to complete space and app names
+	OrgName string
+	//SpaceName is required to complete app names
+	SpaceName string
+	//Prefix is what has been typed of the name so far. Case is ignored.
+	Prefix string
+}
+
+//CompleteOutput contains the return values from a call to Complete()
+type CompleteOutput struct {
+	Type  string   `yaml:"type" json:"type"`
+	Names []string `yaml:"names" json:"names"`
+	Count int      `yaml:"count" json:"count"`
+}
+
+//ReceiveJSON makes CompleteOutput an implementation of SeekerOutput
+func (o *CompleteOutput) ReceiveJSON(j []byte) (err error) {
+	err = json.Unmarshal(j, o)
+	return
+}
+
+//Complete lists the org, space, or app names that begin with the given prefix.
+// Names come from listings of the CF API that are kept for a while, so names
+// can be completed as they are typed.
+func Complete(s *seeker.Seeker, in CompleteInput) (output *CompleteOutput, err error) {
+	log.Debugf("Beginning evaluation of complete command")
+	ret := CompleteOutput{Type: in.Type}
+	switch in.Type {
+	case CompleteTypeOrg:
+		ret.Names, err = s.CompleteOrgNames(in.Prefix)
+	case CompleteTypeSpace:
+		if in.OrgName == "" {
+			err = inputErrorf("an org name is required to complete space names")
+			return
+		}
+		ret.Names, err = s.CompleteSpaceNames(in.OrgName, in.Prefix)
+	case CompleteTypeApp:
+		if in.OrgName == "" || in.SpaceName == "" {
+			err = inputErrorf("org and space names are required to complete app names")
+			return
+		}
+		ret.Names, err = s.CompleteAppNames(in.OrgName, in.SpaceName, in.Prefix)
+	default:
+		err = inputErrorf("type must be one of `%s`, `%s`, or `%s`", CompleteTypeOrg, CompleteTypeSpace, CompleteTypeApp)
+		return
+	}
+	if err != nil {
+		return
+	}
+	if ret.Names == nil {
+		ret.Names = []string{}
+	}
+	ret.Count = len(ret.Names)
+
+	output = &ret
+	return
+}
@@ -58,10 +58,11 @@ func (c ConvertInput) Validate() (err error) {
 }
 
 //ConvertOutput is a struct representing the information returned from a call
-// to convert.
+// to convert. When a GUID of a resource other than an org, space, or app is
+// converted, the org and space it belongs to are given, if it belongs to any.
 type ConvertOutput struct {
 	//OrgGUID is the GUID of the org requested
-	OrgGUID string `yaml:"org_guid" json:"org_guid"`
+	OrgGUID string `yaml:"org_guid,omitempty" json:"org_guid,omitempty"`
 	//SpaceGUID is the GUID of the space requested, given back if space or app was
 	// requested.
 	SpaceGUID string `yaml:"space_guid,omitempty" json:"space_guid,omitempty"`
@@ -69,15 +70,29 @@ type ConvertOutput struct {
 	// was requested
 	AppGUID string `yaml:"app_guid,omitempty" json:"app_guid,omitempty"`
 	//OrgName is the name of the org requested
-	OrgName string `yaml:"org_name" json:"org_name"`
+	OrgName string `yaml:"org_name,omitempty" json:"org_name,omitempty"`
 	//SpaceName is the name of the space requested, given back only if space or
 	// app info was requested
 	SpaceName string `yaml:"space_name,omitempty" json:"space_name,omitempty"`
 	//AppName is the name of the app requested, given back only if app info was
 	// requested
 	AppName string `yaml:"app_name,omitempty" json:"app_name,omitempty"`
-	//Type of resource returned. One of `app`, `space`, or `org`
-	Type string `yaml:"type" json:"type"`
+	//Type of resource returned. One of the ConvertType values
+	Type string `yaml:"type,omitempty" json:"type,omitempty"`
+	//GUID and Name are given back for resources other than orgs, spaces, and
+	// apps. Name is the URL of a route, and the app and service instance of a
+	// service binding that has no name of its own.
+	GUID string `yaml:"guid,omitempty" json:"guid,omitempty"`
+	Name string `yaml:"name,omitempty" json:"name,omitempty"`
+	//ServiceInstanceGUID and ServiceInstanceName are given back for service
+	// bindings and keys. The app of a service binding is given in AppGUID and
+	// AppName.
+	ServiceInstanceGUID string `yaml:"service_instance_guid,omitempty" json:"service_instance_guid,omitempty"`
+	ServiceInstanceName string `yaml:"service_instance_name,omitempty" json:"service_instance_name,omitempty"`
+	//Error is only given in the output of ConvertBatch, for a GUID that couldn't
+	// be converted. NotFound is true if that is because the GUID doesn't exist.
+	Error    string `yaml:"error,omitempty" json:"error,omitempty"`
+	NotFound bool   `yaml:"not_found,omitempty" json:"not_found,omitempty"`
 }
 
 //ReceiveJSON allows this to implement SeekerOutput
@@ -88,11 +103,33 @@ func (c *ConvertOutput) ReceiveJSON(j []byte) (err error) {
 
 var (
 	//ConvertTypeOrg indicates that the output returned represents an org
-	ConvertTypeOrg = "org"
+	ConvertTypeOrg = seeker.ResourceTypeOrg
 	//ConvertTypeSpace indicates that the output returned represents a space
-	ConvertTypeSpace = "space"
+	ConvertTypeSpace = seeker.ResourceTypeSpace
 	//ConvertTypeApp indicates that the output returned represents an app
-	ConvertTypeApp = "app"
+	ConvertTypeApp = seeker.ResourceTypeApp
+	//ConvertTypeRoute indicates that the output returned represents a route
+	ConvertTypeRoute = seeker.ResourceTypeRoute
+	//ConvertTypeDomain indicates that the output returned represents a shared or
+	// private domain
+	ConvertTypeDomain = seeker.ResourceTypeDomain
+	//ConvertTypeServiceInstance indicates that the output returned represents a
+	// managed or user-provided service instance
+	ConvertTypeServiceInstance = seeker.ResourceTypeServiceInstance
+	//ConvertTypeServiceBinding indicates that the output returned represents a
+	// service binding
+	ConvertTypeServiceBinding = seeker.ResourceTypeServiceBinding
+	//ConvertTypeServiceKey indicates that the output returned represents a
+	// service key
+	ConvertTypeServiceKey = seeker.ResourceTypeServiceKey
+	//ConvertTypeSecurityGroup indicates that the output returned represents an
+	// application security group
+	ConvertTypeSecurityGroup = seeker.ResourceTypeSecurityGroup
+	//ConvertTypeStack indicates that the output returned represents a stack
+	ConvertTypeStack = seeker.ResourceTypeStack
+	//ConvertTypeBuildpack indicates that the output returned represents a
+	// buildpack
+	ConvertTypeBuildpack = seeker.ResourceTypeBuildpack
 )
 
 //Convert takes the input of the names of spaces, orgs, and/or apps and gives you
@@ -123,32 +160,33 @@ func Convert(s *seeker.Seeker, in ConvertInput) (out *ConvertOutput, err error)
 	return
 }
 
+//convGUID resolves what type of resource the GUID is, and then fills in what
+// the resource belongs to
 func convGUID(s *seeker.Seeker, in ConvertInput) (out *ConvertOutput, err error) {
 	log.Debugf("Beginning conversion lookup by GUID")
-	out = &ConvertOutput{}
-
-	if out, err = convAppByGUID(s, in); err == nil {
-	} else if out, err = convSpaceByGUID(s, in); err == nil {
-	} else if out, err = convOrgByGUID(s, in); err == nil {
-	} else {
-		log.Debugf("All conversion lookups lookups failed")
-		err = fmt.Errorf("Could not look up GUID: %s (does the GUID exist?)", in.GUID)
+	resource, found, err := s.ResolveGUID(in.GUID)
+	if err != nil {
+		err = fmt.Errorf("Error looking up GUID: %s", err.Error())
+		return
 	}
-
-	return
+	if !found {
+		log.Debugf("All conversion lookups came back not found")
+		err = notFoundErrorf("Could not look up GUID: %s (does the GUID exist?)", in.GUID)
+		return
+	}
+	return convResource(s, resource)
 }
 
 func convOrgByGUID(s *seeker.Seeker, in ConvertInput) (out *ConvertOutput, err error) {
 	log.Debugf("Getting org by GUID")
 	out = &ConvertOutput{}
-	org, err := s.CF.GetOrgByGuid(in.GUID)
+	out.OrgName, err = s.OrgByGUID(in.GUID)
 	if err != nil {
 		err = fmt.Errorf("Error getting CF Org by GUID: %s", err.Error())
 		return
 	}
 
 	out.OrgGUID = in.GUID
-	out.OrgName = org.Name
 	out.Type = ConvertTypeOrg
 
 	log.Debugf("Successful org lookup by GUID")
@@ -158,64 +196,64 @@ func convOrgByGUID(s *seeker.Seeker, in ConvertInput) (out *ConvertOutput, err e
 func convSpaceByGUID(s *seeker.Seeker, in ConvertInput) (out *ConvertOutput, err error) {
 	log.Debugf("Getting space by GUID")
 	out = &ConvertOutput{}
-	space, err := s.CF.GetSpaceByGuid(in.GUID)
+	out.SpaceName, out.OrgGUID, err = s.SpaceByGUID(in.GUID)
 	if err != nil {
 		err = fmt.Errorf("Error getting CF Space by GUID: %s", err.Error())
 		return
 	}
 
 	out.SpaceGUID = in.GUID
-	out.SpaceName = space.Name
 
 	log.Debugf("Getting org associated with space with GUID (%s)", in.GUID)
-	org, err := space.Org()
+	out.OrgName, err = s.OrgByGUID(out.OrgGUID)
 	if err != nil {
 		err = fmt.Errorf("Error getting CF Org associated with space with GUID: %s", in.GUID)
 		return
 	}
 
-	out.OrgGUID = org.Guid
-	out.OrgName = org.Name
 	out.Type = ConvertTypeSpace
 
 	log.Debugf("Successful space lookup by GUID")
 	return
 }
 
-func convAppByGUID(s *seeker.Seeker, in ConvertInput) (out *ConvertOutput, err error) {
-	log.Debugf("Getting app by GUID")
-	out = &ConvertOutput{}
-	app, err := s.CF.GetAppByGuid(in.GUID)
-	if err != nil {
-		err = fmt.Errorf("Error getting CF App by GUID: %s", err.Error())
-		return
-	}
-
-	out.AppGUID = in.GUID
-	out.AppName = app.Name
-
-	log.Debugf("Getting space associated with app with GUID (%s)", in.GUID)
-	space, err := app.Space()
-	if err != nil {
-		err = fmt.Errorf("Error getting CF Space associated with app with GUID: %s", in.GUID)
-		return
+//convResource gives the names of the resolved resource, filling in the org and
+// space it belongs to
+func convResource(s *seeker.Seeker, resource *seeker.Resource) (out *ConvertOutput, err error) {
+	log.Debugf("Converting %s with GUID (%s)", resource.Type, resource.GUID)
+	out = &ConvertOutput{Type: resource.Type}
+	switch resource.Type {
+	case ConvertTypeApp:
+		out.AppGUID, out.AppName = resource.GUID, resource.Name
+	case ConvertTypeSpace:
+		out.SpaceGUID, out.SpaceName = resource.GUID, resource.Name
+	case ConvertTypeOrg:
+		out.OrgGUID, out.OrgName = resource.GUID, resource.Name
+	default:
+		out.GUID, out.Name = resource.GUID, resource.Name
+		out.AppGUID, out.AppName = resource.AppGUID, resource.AppName
+		out.ServiceInstanceGUID, out.ServiceInstanceName = resource.ServiceInstanceGUID, resource.ServiceInstanceName
 	}
 
-	out.SpaceGUID = space.Guid
-	out.SpaceName = space.Name
-
-	log.Debugf("Getting org associated with space with GUID (%s)", space.Guid)
-	org, err := space.Org()
-	if err != nil {
-		err = fmt.Errorf("Error getting CF Org associated with space with GUID: %s", space.Guid)
-		return
+	switch {
+	case resource.SpaceGUID != "":
+		var owner *ConvertOutput
+		owner, err = convSpaceByGUID(s, ConvertInput{GUID: resource.SpaceGUID})
+		if err != nil {
+			return
+		}
+		out.SpaceGUID, out.SpaceName = owner.SpaceGUID, owner.SpaceName
+		out.OrgGUID, out.OrgName = owner.OrgGUID, owner.OrgName
+	case resource.OrgGUID != "":
+		var owner *ConvertOutput
+		owner, err = convOrgByGUID(s, ConvertInput{GUID: resource.OrgGUID})
+		if err != nil {
+			return
+		}
+		out.OrgGUID, out.OrgName = owner.OrgGUID, owner.OrgName
 	}
 
-	out.OrgGUID = org.Guid
-	out.OrgName = org.Name
-	out.Type = ConvertTypeApp
-
-	log.Debugf("Successful app lookup by GUID")
+	log.Debugf("Successful %s lookup by GUID", resource.Type)
 	return
 }
 
@@ -223,14 +261,12 @@ func convOrg(s *seeker.Seeker, in ConvertInput) (out *ConvertOutput, err error)
 	log.Debugf("Beginning org conversion lookup")
 	out = &ConvertOutput{}
 	log.Debugf("Getting org by name (%s)", in.OrgName)
-	org, err := s.CF.GetOrgByName(in.OrgName)
+	out.OrgGUID, out.OrgName, err = s.OrgByName(in.OrgName)
 	if err != nil {
-		err = fmt.Errorf("Error getting CF Org information: %s", err.Error())
+		err = lookupErrorf(err, "Error getting CF Org information")
 		return
 	}
 
-	out.OrgName = in.OrgName
-	out.OrgGUID = org.Guid
 	out.Type = ConvertTypeOrg
 	return
 }
@@ -245,14 +281,12 @@ func convSpace(s *seeker.Seeker, in ConvertInput) (out *ConvertOutput, err error
 	}
 
 	log.Debugf("Getting space by name (%s), and org GUID (%s)", in.SpaceName, out.OrgGUID)
-	space, err := s.CF.GetSpaceByName(in.SpaceName, out.OrgGUID)
+	out.SpaceGUID, out.SpaceName, err = s.SpaceByName(out.OrgGUID, in.SpaceName)
 	if err != nil {
-		err = fmt.Errorf("Error getting CF Space information: %s", err.Error())
+		err = lookupErrorf(err, "Error getting CF Space information")
 		return
 	}
 
-	out.SpaceName = in.SpaceName
-	out.SpaceGUID = space.Guid
 	out.Type = ConvertTypeSpace
 	return
 }
@@ -266,15 +300,13 @@ func convApp(s *seeker.Seeker, in ConvertInput) (out *ConvertOutput, err error)
 		return
 	}
 
-	log.Debugf("Getting app by name (%s), space GUID (%s), and org GUID (%s)", in.AppName, out.SpaceGUID, out.OrgGUID)
-	app, err := s.CF.AppByName(in.AppName, out.SpaceGUID, out.OrgGUID)
+	log.Debugf("Getting app by name (%s) and space GUID (%s)", in.AppName, out.SpaceGUID)
+	out.AppGUID, out.AppName, err = s.AppByName(out.SpaceGUID, in.AppName)
 	if err != nil {
-		err = fmt.Errorf("Error getting CF App information: %s", err.Error())
+		err = lookupErrorf(err, "Error getting CF App information")
 		return
 	}
 
-	out.AppName = in.AppName
-	out.AppGUID = app.Guid
 	out.Type = ConvertTypeApp
 	return
 }
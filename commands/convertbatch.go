@@ -0,0 +1,102 @@
+package commands
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/cloudfoundry-community/cfseeker/seeker"
+	"github.com/starkandwayne/goutils/log"
+)
+
+//ConvertBatchInput contains the information required to convert many GUIDs at
+// once
+type ConvertBatchInput struct {
+	//GUIDs are each looked up the same way as the GUID in the input to Convert
+	GUIDs []string
+}
+
+//ConvertBatchOutput contains the return values from a call to ConvertBatch().
+// There is one entry in Results for each GUID in the input, in the same order.
+// Entries for GUIDs that couldn't be converted have an error instead of names.
+type ConvertBatchOutput struct {
+	Results []ConvertOutput `yaml:"results" json:"results"`
+	Count   int             `yaml:"count" json:"count"`
+	Failed  int             `yaml:"failed" json:"failed"`
+	//NotFound counts the failed GUIDs that don't exist, as opposed to those that
+	// couldn't be looked up
+	NotFound int `yaml:"not_found" json:"not_found"`
+}
+
+//ReceiveJSON makes ConvertBatchOutput an implementation of SeekerOutput
+func (c *ConvertBatchOutput) ReceiveJSON(j []byte) (err error) {
+	err = json.Unmarshal(j, c)
+	return
+}
+
+//ConvertBatch gives the names of what each of the given GUIDs represents. A
+// failure to convert one GUID does not stop the others from being converted.
+func ConvertBatch(s *seeker.Seeker, in ConvertBatchInput) (output *ConvertBatchOutput, err error) {
+	log.Debugf("Beginning evaluation of batch convert command")
+	if len(in.GUIDs) == 0 {
+		err = inputErrorf("no GUIDs specified")
+		return
+	}
+	for _, guid := range in.GUIDs {
+		if guid == "" {
+			err = inputErrorf("GUIDs cannot be empty")
+			return
+		}
+	}
+
+	ret := ConvertBatchOutput{Results: convGUIDs(s, in.GUIDs)}
+	ret.Count = len(ret.Results)
+	for _, result := range ret.Results {
+		if result.Error != "" {
+			ret.Failed++
+		}
+		if result.NotFound {
+			ret.NotFound++
+		}
+	}
+
+	output = &ret
+	return
+}
+
+//convGUIDs converts each of the given GUIDs, with at most s.Workers()
+// conversions in flight at once. The seeker limits how many of their lookups
+// are made at once. The results are in the same order as the GUIDs. If a
+// conversion fails, its entry holds the error instead of the names.
+func convGUIDs(s *seeker.Seeker, guids []string) []ConvertOutput {
+	ret := make([]ConvertOutput, len(guids))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for i := s.Workers(); i > 0; i-- {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range jobs {
+				guid := guids[idx]
+				converted, err := convGUID(s, ConvertInput{GUID: guid})
+				if err != nil {
+					log.Debugf("Could not convert GUID (%s): %s", guid, err.Error())
+					_, notFound := err.(NotFoundError)
+					ret[idx] = ConvertOutput{
+						GUID:     guid,
+						Error:    err.Error(),
+						NotFound: notFound,
+					}
+					continue
+				}
+				ret[idx] = *converted
+			}
+		}()
+	}
+
+	for i := range guids {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	return ret
+}
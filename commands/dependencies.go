@@ -0,0 +1,208 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/cloudfoundry-community/cfseeker/seeker"
+	"github.com/starkandwayne/goutils/log"
+)
+
+//DependenciesInput contains the information required to perform the
+// dependencies command. The app is named the same way as for Find.
+type DependenciesInput struct {
+	AppGUID   string
+	OrgName   string
+	SpaceName string
+	AppName   string
+}
+
+//DependenciesOutput contains the return values from a call to Dependencies()
+type DependenciesOutput struct {
+	AppGUID string `yaml:"guid" json:"guid"`
+	AppName string `yaml:"name" json:"name"`
+	//Cells are the BOSH VMs that the running instances of the app are on
+	Cells    []DependencyVM      `yaml:"cells" json:"cells"`
+	Services []DependencyService `yaml:"services" json:"services"`
+	//DependsOn lists each BOSH VM that the hosts in the app's service bindings
+	// point to
+	DependsOn []DependencyVM `yaml:"depends_on" json:"depends_on"`
+	Count     int            `yaml:"count" json:"count"`
+	//warnings are returned in the response metadata instead of the contents
+	warnings []string
+}
+
+//ReceiveJSON makes DependenciesOutput an implementation of SeekerOutput
+func (d *DependenciesOutput) ReceiveJSON(j []byte) (err error) {
+	err = json.Unmarshal(j, d)
+	return
+}
+
+//Warnings returns the problems noticed while finding the app and resolving the
+// hosts of its service bindings
+func (d *DependenciesOutput) Warnings() []string {
+	return d.warnings
+}
+
+//DependencyService is a service instance bound to the app
+type DependencyService struct {
+	Name  string           `yaml:"name" json:"name"`
+	GUID  string           `yaml:"guid" json:"guid"`
+	Label string           `yaml:"label,omitempty" json:"label,omitempty"`
+	Hosts []DependencyHost `yaml:"hosts" json:"hosts"`
+}
+
+//DependencyHost is a host found in the credentials of a service binding, along
+// with the BOSH VMs that it resolves to. VMs is empty for hosts outside of the
+// configured deployments.
+type DependencyHost struct {
+	Host string         `yaml:"host" json:"host"`
+	IPs  []string       `yaml:"ips,omitempty" json:"ips,omitempty"`
+	VMs  []DependencyVM `yaml:"vms,omitempty" json:"vms,omitempty"`
+}
+
+//DependencyVM is a BOSH VM on either side of an app's dependencies
+type DependencyVM struct {
+	VMName     string `yaml:"vm_name" json:"vm_name"`
+	Deployment string `yaml:"deployment" json:"deployment"`
+	Director   string `yaml:"director" json:"director"`
+	//IP is only given for the VMs that a host resolves to
+	IP string `yaml:"ip,omitempty" json:"ip,omitempty"`
+	//Instances are the numbers of the app instances on the VM. They are only
+	// given for cells.
+	Instances []int `yaml:"instances,omitempty" json:"instances,omitempty"`
+}
+
+//Dependencies determines which BOSH VMs the given app runs on, and which BOSH
+// VMs it talks to according to the credentials of its service bindings
+func Dependencies(s *seeker.Seeker, in DependenciesInput) (output *DependenciesOutput, err error) {
+	log.Debugf("Beginning evaluation of dependencies command")
+	if !s.BOSHConfigured() {
+		err = inputErrorf("BOSH must be configured to find the dependencies of an app")
+		return
+	}
+
+	app, err := Find(s, FindInput{
+		AppGUID:   in.AppGUID,
+		OrgName:   in.OrgName,
+		SpaceName: in.SpaceName,
+		AppName:   in.AppName,
+	})
+	if err != nil {
+		return
+	}
+
+	ret := DependenciesOutput{
+		AppGUID:   app.AppGUID,
+		AppName:   app.AppName,
+		Cells:     cellsOf(app.Instances),
+		Services:  []DependencyService{},
+		DependsOn: []DependencyVM{},
+		warnings:  app.Warnings(),
+	}
+
+	services, err := s.AppServiceDependencies(app.AppGUID)
+	if err != nil {
+		return
+	}
+
+	ipsOfHost := map[string][]string{}
+	var allIPs []string
+	for _, service := range services {
+		for _, host := range service.Hosts {
+			if _, resolved := ipsOfHost[host]; resolved {
+				continue
+			}
+			ips, resolveErr := seeker.ResolveHost(host)
+			if resolveErr != nil {
+				ret.warnings = append(ret.warnings, fmt.Sprintf("Could not resolve host `%s` of service `%s`: %s", host, service.ServiceName, resolveErr.Error()))
+			}
+			ipsOfHost[host] = ips
+			allIPs = append(allIPs, ips...)
+		}
+	}
+
+	vms, err := s.GetCachedVMsWithIPs(allIPs)
+	if err != nil {
+		return
+	}
+
+	dependsOn := map[string]bool{}
+	for _, service := range services {
+		found := DependencyService{
+			Name:  service.ServiceName,
+			GUID:  service.ServiceGUID,
+			Label: service.Label,
+			Hosts: []DependencyHost{},
+		}
+		for _, host := range service.Hosts {
+			foundHost := DependencyHost{Host: host, IPs: ipsOfHost[host]}
+			for _, ip := range foundHost.IPs {
+				vm := vms[ip]
+				if vm == nil {
+					continue
+				}
+				dep := DependencyVM{
+					VMName:     fmt.Sprintf("%s/%d", vm.JobName, vm.Index),
+					Deployment: vm.DeploymentName,
+					Director:   vm.Director,
+				}
+				key := dep.Director + "/" + dep.Deployment + "/" + dep.VMName
+				if !dependsOn[key] {
+					dependsOn[key] = true
+					ret.DependsOn = append(ret.DependsOn, dep)
+				}
+				dep.IP = ip
+				foundHost.VMs = append(foundHost.VMs, dep)
+			}
+			found.Hosts = append(found.Hosts, foundHost)
+		}
+		ret.Services = append(ret.Services, found)
+	}
+
+	sort.Sort(dependencyVMsByName(ret.DependsOn))
+	ret.Count = len(ret.DependsOn)
+
+	output = &ret
+	return
+}
+
+//cellsOf gathers the given instances by the BOSH VM they are on
+func cellsOf(instances []FindInstance) (ret []DependencyVM) {
+	ret = []DependencyVM{}
+	index := map[string]int{}
+	for _, instance := range instances {
+		if instance.VMName == "" {
+			continue
+		}
+		key := instance.Director + "/" + instance.Deployment + "/" + instance.VMName
+		i, found := index[key]
+		if !found {
+			i = len(ret)
+			index[key] = i
+			ret = append(ret, DependencyVM{
+				VMName:     instance.VMName,
+				Deployment: instance.Deployment,
+				Director:   instance.Director,
+			})
+		}
+		ret[i].Instances = append(ret[i].Instances, instance.InstanceNumber)
+	}
+	sort.Sort(dependencyVMsByName(ret))
+	return
+}
+
+type dependencyVMsByName []DependencyVM
+
+func (d dependencyVMsByName) Len() int      { return len(d) }
+func (d dependencyVMsByName) Swap(i, j int) { d[i], d[j] = d[j], d[i] }
+func (d dependencyVMsByName) Less(i, j int) bool {
+	switch {
+	case d[i].Director != d[j].Director:
+		return d[i].Director < d[j].Director
+	case d[i].Deployment != d[j].Deployment:
+		return d[i].Deployment < d[j].Deployment
+	}
+	return d[i].VMName < d[j].VMName
+}
@@ -1,6 +1,17 @@
 package commands
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cloudfoundry-community/cfseeker/seeker"
+)
+
+//Warner is implemented by command outputs that can carry warnings about
+// problems which didn't stop the command from succeeding
+type Warner interface {
+	Warnings() []string
+}
 
 //InputError represents there having been a problem with the arguments given as
 // the input of the command
@@ -15,3 +26,27 @@ func inputErrorf(format string, args ...interface{}) InputError {
 func (e InputError) Error() string {
 	return e.message
 }
+
+//NotFoundError represents the thing the command was asked about not existing
+type NotFoundError struct {
+	message string
+}
+
+func notFoundErrorf(format string, args ...interface{}) NotFoundError {
+	return NotFoundError{message: fmt.Sprintf(format, args...)}
+}
+
+func (e NotFoundError) Error() string {
+	return e.message
+}
+
+//lookupErrorf prefixes the given error from a lookup with the formatted
+// message. If the lookup didn't find the named thing, a NotFoundError is
+// returned.
+func lookupErrorf(err error, format string, args ...interface{}) error {
+	message := fmt.Sprintf(format, args...) + ": " + err.Error()
+	if _, notFound := err.(seeker.NameNotFoundError); notFound {
+		return NotFoundError{message: message}
+	}
+	return errors.New(message)
+}
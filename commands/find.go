@@ -3,7 +3,9 @@ package commands
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/cloudfoundry-community/cfseeker/seeker"
 	"github.com/starkandwayne/goutils/log"
@@ -12,18 +14,45 @@ import (
 //FindInput contains the information required to perform the find command
 // Either should be the org, space, and app names, or just the app GUID
 type FindInput struct {
-	AppGUID   string
-	OrgName   string
-	SpaceName string
-	AppName   string
+	AppGUID   string `json:"app_guid,omitempty"`
+	OrgName   string `json:"org_name,omitempty"`
+	SpaceName string `json:"space_name,omitempty"`
+	AppName   string `json:"app_name,omitempty"`
+	//AllInstances includes instances that aren't running in the output. These
+	// instances have no host or port.
+	AllInstances bool `json:"-"`
+	//Usage includes the resource usage, quotas, uptime, and URIs of each running
+	// instance in the output. The instance index is never used to answer these
+	// requests, so that the usage is current.
+	Usage bool `json:"-"`
+	//Vitals includes the vitals of the BOSH VM each running instance is placed
+	// on in the output. This requires BOSH to be configured.
+	Vitals bool `json:"-"`
 }
 
 //FindOutput contains the return values from a call to Find()
 type FindOutput struct {
-	AppGUID   string         `yaml:"guid" json:"guid"`
-	AppName   string         `yaml:"name" json:"name"`
+	AppGUID string `yaml:"guid" json:"guid"`
+	AppName string `yaml:"name" json:"name"`
+	//SpaceName is only given when finding every app in an org
+	SpaceName string         `yaml:"space_name,omitempty" json:"space_name,omitempty"`
 	Instances []FindInstance `yaml:"instances" json:"instances"`
 	Count     int            `yaml:"count" json:"count"`
+	//Processes is only given if the v3 CF backend is in use. Instances are
+	// listed in the same order as their processes.
+	Processes []FindProcess `yaml:"processes,omitempty" json:"processes,omitempty"`
+	//Error is set instead of Instances when this app is one of many being found
+	// and it could not be found
+	Error string `yaml:"error,omitempty" json:"error,omitempty"`
+	//IndexedAt is when the crawler built the index this was answered from, if
+	// it was answered from the crawler's index
+	IndexedAt string `yaml:"indexed_at,omitempty" json:"indexed_at,omitempty"`
+	Stack     string `yaml:"stack,omitempty" json:"stack,omitempty"`
+	//IsolationSegment is empty if the app runs on the shared cells
+	IsolationSegment string   `yaml:"isolation_segment,omitempty" json:"isolation_segment,omitempty"`
+	PlacementTags    []string `yaml:"placement_tags,omitempty" json:"placement_tags,omitempty"`
+	//warnings are returned in the response metadata instead of the contents
+	warnings []string
 }
 
 //ReceiveJSON makes FindOutput an implementation of SeekerOutput
@@ -32,13 +61,132 @@ func (f *FindOutput) ReceiveJSON(j []byte) (err error) {
 	return
 }
 
+//Warnings returns the problems noticed while finding the app which didn't stop
+// it from being found, such as instances running outside their isolation segment
+func (f *FindOutput) Warnings() []string {
+	return f.warnings
+}
+
 //FindInstance represents information about one instance of an app
 type FindInstance struct {
-	InstanceNumber int    `yaml:"number" json:"number"`
-	VMName         string `yaml:"vm_name,omitempty" json:"vm_name,omitempty"`
-	Deployment     string `yaml:"deployment,omitempty" json:"deployment,omitempty"`
-	Host           string `yaml:"host" json:"host"`
-	Port           int    `yaml:"port" json:"port"`
+	InstanceNumber int          `yaml:"number" json:"number"`
+	State          string       `yaml:"state" json:"state"`
+	ProcessType    string       `yaml:"process_type,omitempty" json:"process_type,omitempty"`
+	VMName         string       `yaml:"vm_name,omitempty" json:"vm_name,omitempty"`
+	Deployment     string       `yaml:"deployment,omitempty" json:"deployment,omitempty"`
+	Director       string       `yaml:"director,omitempty" json:"director,omitempty"`
+	AZ             string       `yaml:"az,omitempty" json:"az,omitempty"`
+	Host           string       `yaml:"host,omitempty" json:"host,omitempty"`
+	Port           InstancePort `yaml:"port,omitempty" json:"port,omitempty"`
+	//InternalIP and Ports are only given if the v3 CF backend is in use
+	InternalIP string            `yaml:"internal_ip,omitempty" json:"internal_ip,omitempty"`
+	Ports      []FindPortMapping `yaml:"ports,omitempty" json:"ports,omitempty"`
+	//VMIdentity is only given if BOSH is configured
+	VMIdentity `yaml:",inline"`
+	//Usage is only given if it was asked for, and only for running instances
+	Usage *FindUsage `yaml:"usage,omitempty" json:"usage,omitempty"`
+	//Vitals is only given if it was asked for, and only for running instances
+	Vitals *FindVitals `yaml:"vitals,omitempty" json:"vitals,omitempty"`
+}
+
+//FindProcess summarizes one process type of an app
+type FindProcess struct {
+	Type string `yaml:"type" json:"type"`
+	//Instances is how many instances of the process there should be
+	Instances int `yaml:"instances" json:"instances"`
+	//Running is how many instances of the process are running
+	Running  int      `yaml:"running" json:"running"`
+	Sidecars []string `yaml:"sidecars,omitempty" json:"sidecars,omitempty"`
+}
+
+//InstancePort is the port on its host that an app instance listens on. It is
+// given as "unknown" for a running instance whose port the CF API didn't report.
+type InstancePort int
+
+const unknownPortName = "unknown"
+
+//MarshalJSON gives the port as a number, or as "unknown"
+func (p InstancePort) MarshalJSON() ([]byte, error) {
+	if p == seeker.UnknownPort {
+		return json.Marshal(unknownPortName)
+	}
+	return json.Marshal(int(p))
+}
+
+//UnmarshalJSON reads a port given by MarshalJSON
+func (p *InstancePort) UnmarshalJSON(data []byte) error {
+	var name string
+	if json.Unmarshal(data, &name) == nil && name == unknownPortName {
+		*p = seeker.UnknownPort
+		return nil
+	}
+	return json.Unmarshal(data, (*int)(p))
+}
+
+//MarshalYAML gives the port as a number, or as "unknown"
+func (p InstancePort) MarshalYAML() (interface{}, error) {
+	if p == seeker.UnknownPort {
+		return unknownPortName, nil
+	}
+	return int(p), nil
+}
+
+//FindPortMapping maps a port on the host of an app instance to the port in its
+// container
+type FindPortMapping struct {
+	External int `yaml:"external" json:"external"`
+	Internal int `yaml:"internal" json:"internal"`
+}
+
+//VMIdentity says which BOSH instance and IaaS VM an app instance is placed on
+type VMIdentity struct {
+	//InstanceName is the name of the BOSH instance in the form <job>/<uuid>, as
+	// taken by bosh ssh and bosh recreate
+	InstanceName string `yaml:"instance_name,omitempty" json:"instance_name,omitempty"`
+	InstanceID   string `yaml:"instance_id,omitempty" json:"instance_id,omitempty"`
+	VMCID        string `yaml:"vm_cid,omitempty" json:"vm_cid,omitempty"`
+	AgentID      string `yaml:"agent_id,omitempty" json:"agent_id,omitempty"`
+	VMType       string `yaml:"vm_type,omitempty" json:"vm_type,omitempty"`
+	ResourcePool string `yaml:"resource_pool,omitempty" json:"resource_pool,omitempty"`
+	JobState     string `yaml:"job_state,omitempty" json:"job_state,omitempty"`
+	Bootstrap    bool   `yaml:"bootstrap,omitempty" json:"bootstrap,omitempty"`
+}
+
+//FindUsage has the resource usage of one instance of an app. Memory and disk
+// are given in bytes.
+type FindUsage struct {
+	CPUPercent float64  `yaml:"cpu_percent" json:"cpu_percent"`
+	Mem        int      `yaml:"mem" json:"mem"`
+	MemQuota   int      `yaml:"mem_quota" json:"mem_quota"`
+	Disk       int      `yaml:"disk" json:"disk"`
+	DiskQuota  int      `yaml:"disk_quota" json:"disk_quota"`
+	FdsQuota   int      `yaml:"fds_quota" json:"fds_quota"`
+	Uptime     int      `yaml:"uptime" json:"uptime"` //in seconds
+	URIs       []string `yaml:"uris" json:"uris"`
+	ReportedAt string   `yaml:"reported_at" json:"reported_at"`
+}
+
+//FindVitals has the vitals of the BOSH VM an app instance is placed on, as BOSH
+// last reported them. Percentages are out of 100.
+type FindVitals struct {
+	//Load holds the 1, 5, and 15 minute load averages
+	Load        []float64 `yaml:"load" json:"load"`
+	CPUUser     float64   `yaml:"cpu_user" json:"cpu_user"`
+	CPUSys      float64   `yaml:"cpu_sys" json:"cpu_sys"`
+	CPUWait     float64   `yaml:"cpu_wait" json:"cpu_wait"`
+	MemPercent  float64   `yaml:"mem_percent" json:"mem_percent"`
+	MemKB       int       `yaml:"mem_kb" json:"mem_kb"`
+	SwapPercent float64   `yaml:"swap_percent" json:"swap_percent"`
+	SwapKB      int       `yaml:"swap_kb" json:"swap_kb"`
+	//DiskPercent maps each disk of the VM to how full it is
+	DiskPercent map[string]float64 `yaml:"disk_percent" json:"disk_percent"`
+	//Processes maps each process monitored by BOSH on the VM to its state
+	Processes  map[string]string `yaml:"processes,omitempty" json:"processes,omitempty"`
+	ReportedAt string            `yaml:"reported_at" json:"reported_at"`
+	//Overloaded is true if any of the vitals is at or above its configured
+	// threshold, or a process isn't running. Alerts says which.
+	Overloaded bool     `yaml:"overloaded" json:"overloaded"`
+	Alerts     []string `yaml:"alerts,omitempty" json:"alerts,omitempty"`
 }
 
 //Find determines the location of the app you requests
@@ -49,21 +197,36 @@ func Find(s *seeker.Seeker, in FindInput) (output *FindOutput, err error) {
 	if err != nil {
 		return
 	}
+	err = validateVitals(s, in.Vitals)
+	if err != nil {
+		return
+	}
 
 	var meta *seeker.AppMeta
 	var instances []seeker.AppInstance
+	var found bool
 
-	if in.AppGUID != "" {
+	if index := s.CurrentIndex(); index != nil && !in.Usage {
+		log.Debugf("Finding IPs in instance index")
+		meta, instances, found = findInIndex(index, in)
+		if found {
+			ret.IndexedAt = formatIndexTime(index.BuiltAt)
+		}
+	}
+
+	switch {
+	case found:
+	case in.AppGUID != "":
 		log.Debugf("Finding IPs by GUID")
 		ret.AppGUID = in.AppGUID
 		meta, instances, err = s.FindInstances(s.ByGUID(in.AppGUID))
-	} else {
+	default:
 		log.Debugf("Finding IPs by Org, Space, and App Name")
-		meta, instances, err = s.FindInstances(s.ByOrgSpaceAndName(in.OrgName, in.SpaceName, in.AppName))
+		meta, instances, err = s.FindInstancesByName(in.OrgName, in.SpaceName, in.AppName)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("Error while getting VM IPs: %s", err.Error())
+		err = lookupErrorf(err, "Error while getting VM IPs")
 		return
 	}
 
@@ -72,16 +235,67 @@ func Find(s *seeker.Seeker, in FindInput) (output *FindOutput, err error) {
 	ret.AppGUID = meta.GUID
 	ret.AppName = meta.Name
 
-	for i, instance := range instances {
-		ret.Instances = append(ret.Instances, FindInstance{
-			InstanceNumber: i,
+	for _, instance := range instances {
+		if !instance.Running() && !in.AllInstances {
+			continue
+		}
+
+		found := FindInstance{
+			InstanceNumber: instance.Index,
+			State:          instance.State,
+			ProcessType:    instance.ProcessType,
 			Host:           instance.Host,
-			Port:           instance.Port,
-		})
+			Port:           InstancePort(instance.Port),
+			InternalIP:     instance.InternalIP,
+		}
+		for _, port := range instance.Ports {
+			found.Ports = append(found.Ports, FindPortMapping{External: port.External, Internal: port.Internal})
+		}
+		if in.Usage && instance.Usage != nil {
+			found.Usage = &FindUsage{
+				CPUPercent: instance.Usage.CPU * 100,
+				Mem:        instance.Usage.Mem,
+				MemQuota:   instance.Usage.MemQuota,
+				Disk:       instance.Usage.Disk,
+				DiskQuota:  instance.Usage.DiskQuota,
+				FdsQuota:   instance.Usage.FdsQuota,
+				Uptime:     int(instance.Usage.Uptime / time.Second),
+				URIs:       instance.Usage.URIs,
+				ReportedAt: instance.Usage.ReportedAt.UTC().Format(time.RFC3339),
+			}
+		}
+		ret.Instances = append(ret.Instances, found)
+	}
+
+	sort.Sort(findInstancesByProcess(ret.Instances))
+
+	for _, process := range meta.Processes {
+		found := FindProcess{Type: process.Type, Instances: process.Instances, Sidecars: process.Sidecars}
+		for _, instance := range instances {
+			if instance.ProcessType == process.Type && instance.Running() {
+				found.Running++
+			}
+		}
+		ret.Processes = append(ret.Processes, found)
 	}
 
 	if s.BOSHConfigured() {
-		lookupAndAssignBOSHInfo(ret.Instances, s)
+		ret.warnings = append(ret.warnings, lookupAndAssignBOSHInfo(ret.AppName, ret.Instances, s, in.Vitals)...)
+	}
+
+	log.Debugf("Looking up placement of app with GUID %s", ret.AppGUID)
+	placement, placementErr := s.AppPlacement(ret.AppGUID)
+	if placementErr != nil {
+		ret.warnings = append(ret.warnings, fmt.Sprintf("Could not look up the stack and isolation segment of app `%s`: %s", ret.AppName, placementErr.Error()))
+	} else {
+		ret.Stack = placement.Stack
+		ret.IsolationSegment = placement.IsolationSegment
+		ret.PlacementTags = placement.PlacementTags
+		//Instances can only be misplaced if segments are mapped to deployments,
+		// and the deployments hosting the instances are known
+		if s.SegmentsMapped() && placedOnBOSH(ret.Instances) {
+			ret.warnings = append(ret.warnings, misplacedInstances(s, &ret)...)
+		}
 	}
 
 	ret.Count = len(ret.Instances)
@@ -90,30 +304,173 @@ func Find(s *seeker.Seeker, in FindInput) (output *FindOutput, err error) {
 	return
 }
 
-func lookupAndAssignBOSHInfo(instances []FindInstance, s *seeker.Seeker) (err error) {
+//findInIndex looks up the app described by the input in the given index. If
+// the app isn't in the index, found is false.
+func findInIndex(index *seeker.InstanceIndex, in FindInput) (meta *seeker.AppMeta, instances []seeker.AppInstance, found bool) {
+	guid := in.AppGUID
+	if guid == "" {
+		guid, found = index.AppGUID(in.OrgName, in.SpaceName, in.AppName)
+		if !found {
+			return
+		}
+	}
+	return index.ForApp(guid)
+}
+
+//placedOnBOSH returns true if any of the given instances was found on a BOSH VM
+func placedOnBOSH(instances []FindInstance) bool {
+	for _, instance := range instances {
+		if instance.Deployment != "" {
+			return true
+		}
+	}
+	return false
+}
+
+//misplacedInstances returns a warning for each instance of the found app that
+// is hosted by a different BOSH deployment than its isolation segment is mapped
+// to in the config. Apps on the shared segment are expected to be hosted by a
+// deployment that isn't mapped to any isolation segment.
+func misplacedInstances(s *seeker.Seeker, app *FindOutput) (warnings []string) {
+	wantDirector, wantDeployment, mapped := s.SegmentDeployment(app.IsolationSegment)
+	for _, instance := range app.Instances {
+		if instance.Deployment == "" {
+			continue
+		}
+
+		name := fmt.Sprintf("Instance %d of app `%s`", instance.InstanceNumber, app.AppName)
+		if instance.ProcessType != "" {
+			name = fmt.Sprintf("Instance %d of process `%s` of app `%s`", instance.InstanceNumber, instance.ProcessType, app.AppName)
+		}
+
+		switch {
+		case app.IsolationSegment != "" && mapped:
+			if instance.Director != wantDirector || instance.Deployment != wantDeployment {
+				warnings = append(warnings, fmt.Sprintf("%s is on deployment `%s` of director `%s`, but isolation segment `%s` is mapped to deployment `%s` of director `%s`",
+					name, instance.Deployment, instance.Director, app.IsolationSegment, wantDeployment, wantDirector))
+			}
+		case app.IsolationSegment == "":
+			if segment, found := s.DeploymentSegment(instance.Director, instance.Deployment); found {
+				warnings = append(warnings, fmt.Sprintf("%s is on deployment `%s` of director `%s`, which is mapped to isolation segment `%s`, but the app runs on the shared segment",
+					name, instance.Deployment, instance.Director, segment))
+			}
+		}
+	}
+	return
+}
+
+//lookupAndAssignBOSHInfo fills in the BOSH VM hosting each of the given
+// instances of the named app. If the VM of an instance can't be looked up, a
+// warning is returned for it, and the other instances are still looked up.
+func lookupAndAssignBOSHInfo(appName string, instances []FindInstance, s *seeker.Seeker, vitals bool) (warnings []string) {
 	for i, instance := range instances {
-		log.Debugf("Looking up VM with IP: %s", instance.Host)
-		var vm *seeker.VMInfo
-		vm, err = s.GetVMWithIP(instance.Host)
+		if instance.Host == "" {
+			continue
+		}
+
+		warn := func(format string, args ...interface{}) {
+			name := fmt.Sprintf("instance %d", instance.InstanceNumber)
+			if instance.ProcessType != "" {
+				name = fmt.Sprintf("instance %d of process `%s`", instance.InstanceNumber, instance.ProcessType)
+			}
+			warnings = append(warnings, fmt.Sprintf("Could not look up the BOSH VM of %s of app `%s`: %s", name, appName, fmt.Sprintf(format, args...)))
+		}
 
+		log.Debugf("Looking up VM with IP: %s", instance.Host)
+		vm, err := s.GetVMWithIP(instance.Host)
 		if err != nil {
-			err = fmt.Errorf("Error while translating VM name for IP `%s`: %s", instance.Host, err.Error())
-			return
+			warn("Error while translating VM name for IP `%s`: %s", instance.Host, err.Error())
+			continue
 		}
 
 		if vm == nil {
-			err = fmt.Errorf("Could not find VM with given IP `%s`", instance.Host)
-			return
+			warn("Could not find VM with given IP `%s`", instance.Host)
+			continue
 		}
 
 		log.Debugf("Got VM with IP: %s", instance.Host)
 
 		instances[i].Deployment = vm.DeploymentName
+		instances[i].Director = vm.Director
 		instances[i].VMName = fmt.Sprintf("%s/%d", vm.JobName, vm.Index)
+		instances[i].AZ = vm.AZ
+		instances[i].VMIdentity = VMIdentity{
+			InstanceID:   vm.ID,
+			VMCID:        vm.VMCID,
+			AgentID:      vm.AgentID,
+			VMType:       vm.VMType,
+			ResourcePool: vm.ResourcePool,
+			JobState:     vm.JobState,
+			Bootstrap:    vm.Bootstrap,
+		}
+		if vm.ID != "" {
+			instances[i].InstanceName = vm.JobName + "/" + vm.ID
+		}
+		if vitals {
+			v, err := s.FreshVitals(vm)
+			if err != nil {
+				warn("Error while getting vitals for IP `%s`: %s", instance.Host, err.Error())
+				continue
+			}
+			instances[i].Vitals = findVitals(s, v)
+		}
 	}
 	return
 }
 
+func findVitals(s *seeker.Seeker, v *seeker.VMVitals) *FindVitals {
+	ret := &FindVitals{
+		Load:        v.Load,
+		CPUUser:     v.CPUUser,
+		CPUSys:      v.CPUSys,
+		CPUWait:     v.CPUWait,
+		MemPercent:  v.MemPercent,
+		MemKB:       v.MemKB,
+		SwapPercent: v.SwapPercent,
+		SwapKB:      v.SwapKB,
+		DiskPercent: v.DiskPercent,
+		ReportedAt:  v.ReportedAt.UTC().Format(time.RFC3339),
+		Alerts:      s.VitalsAlerts(v),
+	}
+	ret.Overloaded = len(ret.Alerts) > 0
+	if len(v.Processes) > 0 {
+		ret.Processes = map[string]string{}
+		for _, proc := range v.Processes {
+			ret.Processes[proc.Name] = proc.State
+		}
+	}
+	return ret
+}
+
+//Narrow drops the VM identity of each instance, leaving only the fields shown
+// by the CLI when --wide isn't given
+func (f *FindOutput) Narrow() {
+	for i := range f.Instances {
+		f.Instances[i].VMIdentity = VMIdentity{}
+	}
+}
+
+//findInstancesByProcess orders instances by process type, with web first, and
+// then by number
+type findInstancesByProcess []FindInstance
+
+func (f findInstancesByProcess) Len() int      { return len(f) }
+func (f findInstancesByProcess) Swap(i, j int) { f[i], f[j] = f[j], f[i] }
+func (f findInstancesByProcess) Less(i, j int) bool {
+	if f[i].ProcessType != f[j].ProcessType {
+		return seeker.ProcessTypeLess(f[i].ProcessType, f[j].ProcessType)
+	}
+	return f[i].InstanceNumber < f[j].InstanceNumber
+}
+
+//validateVitals makes sure that BOSH is configured if VM vitals are asked for
+func validateVitals(s *seeker.Seeker, vitals bool) error {
+	if vitals && !s.BOSHConfigured() {
+		return inputErrorf("BOSH must be configured to get VM vitals")
+	}
+	return nil
+}
+
 func validateFindFlags(in FindInput) error {
 	//Check GUID flags
 	if in.AppGUID != "" {
@@ -136,5 +493,5 @@ func validateFindFlags(in FindInput) error {
 		return nil
 	}
 
-	return inputErrorf(strings.Join(errorMessages, "\n"))
+	return inputErrorf("%s", strings.Join(errorMessages, "\n"))
 }
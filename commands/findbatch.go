@@ -0,0 +1,78 @@
+package commands
+
+import (
+	"encoding/json"
+
+	"github.com/cloudfoundry-community/cfseeker/seeker"
+	"github.com/starkandwayne/goutils/log"
+)
+
+//FindBatchInput contains the information required to find many apps at once
+type FindBatchInput struct {
+	//Apps each name one app to find, the same way as the input to Find does
+	Apps []FindInput
+	//AllInstances includes instances that aren't running in the output
+	AllInstances bool
+	//Usage includes the resource usage of each running instance in the output
+	Usage bool
+	//Vitals includes the vitals of the VM each running instance is on
+	Vitals bool
+}
+
+//FindBatchOutput contains the return values from a call to FindBatch(). There
+// is one entry in Apps for each app in the input, in the same order. Entries
+// for apps that couldn't be found have an error instead of instances.
+type FindBatchOutput struct {
+	Apps   []FindOutput `yaml:"apps" json:"apps"`
+	Count  int          `yaml:"count" json:"count"`
+	Failed int          `yaml:"failed" json:"failed"`
+}
+
+//ReceiveJSON makes FindBatchOutput an implementation of SeekerOutput
+func (f *FindBatchOutput) ReceiveJSON(j []byte) (err error) {
+	err = json.Unmarshal(j, f)
+	return
+}
+
+//Narrow drops the VM identity of the instances of each app
+func (f *FindBatchOutput) Narrow() {
+	narrowAll(f.Apps)
+}
+
+//Warnings returns the warnings from finding each app
+func (f *FindBatchOutput) Warnings() []string {
+	return warningsOf(f.Apps)
+}
+
+//FindBatch determines the location of each of the given apps. A failure to find
+// one app does not stop the others from being found.
+func FindBatch(s *seeker.Seeker, in FindBatchInput) (output *FindBatchOutput, err error) {
+	log.Debugf("Beginning evaluation of batch find command")
+	if len(in.Apps) == 0 {
+		err = inputErrorf("no apps specified")
+		return
+	}
+	err = validateVitals(s, in.Vitals)
+	if err != nil {
+		return
+	}
+
+	toFind := make([]FindInput, len(in.Apps))
+	for i, app := range in.Apps {
+		toFind[i] = app
+		toFind[i].AllInstances = in.AllInstances
+		toFind[i].Usage = in.Usage
+		toFind[i].Vitals = in.Vitals
+	}
+
+	ret := FindBatchOutput{Apps: findApps(s, toFind)}
+	ret.Count = len(ret.Apps)
+	for _, app := range ret.Apps {
+		if app.Error != "" {
+			ret.Failed++
+		}
+	}
+
+	output = &ret
+	return
+}
@@ -0,0 +1,81 @@
+package commands
+
+import (
+	"encoding/json"
+
+	"github.com/cloudfoundry-community/cfseeker/seeker"
+	"github.com/starkandwayne/goutils/log"
+)
+
+//FindRouteInput contains the information required to find the apps behind a
+// route
+type FindRouteInput struct {
+	//URL is the URL of the route, such as https://foo.apps.example.com/api. The
+	// scheme may be left off.
+	URL string
+	//AllInstances includes instances that aren't running in the output
+	AllInstances bool
+	//Usage includes the resource usage of each running instance in the output
+	Usage bool
+	//Vitals includes the vitals of the VM each running instance is on
+	Vitals bool
+}
+
+//FindRouteOutput contains the return values from a call to FindRoute(). There
+// is one entry in Apps for each started app mapped to the route.
+type FindRouteOutput struct {
+	Route     string       `yaml:"route" json:"route"`
+	RouteGUID string       `yaml:"route_guid" json:"route_guid"`
+	Apps      []FindOutput `yaml:"apps" json:"apps"`
+	Count     int          `yaml:"count" json:"count"`
+}
+
+//ReceiveJSON makes FindRouteOutput an implementation of SeekerOutput
+func (f *FindRouteOutput) ReceiveJSON(j []byte) (err error) {
+	err = json.Unmarshal(j, f)
+	return
+}
+
+//Narrow drops the VM identity of the instances of each app
+func (f *FindRouteOutput) Narrow() {
+	narrowAll(f.Apps)
+}
+
+//Warnings returns the warnings from finding each app
+func (f *FindRouteOutput) Warnings() []string {
+	return warningsOf(f.Apps)
+}
+
+//FindRoute determines the location of every app mapped to the route that the
+// given URL points to
+func FindRoute(s *seeker.Seeker, in FindRouteInput) (output *FindRouteOutput, err error) {
+	log.Debugf("Beginning evaluation of find command by route")
+	if in.URL == "" {
+		err = inputErrorf("no URL specified")
+		return
+	}
+	err = validateVitals(s, in.Vitals)
+	if err != nil {
+		return
+	}
+
+	route, apps, err := s.FindAppsByRoute(in.URL)
+	if err != nil {
+		return
+	}
+
+	var toFind []FindInput
+	for _, app := range apps {
+		toFind = append(toFind, FindInput{AppGUID: app.GUID, AllInstances: in.AllInstances, Usage: in.Usage, Vitals: in.Vitals})
+	}
+
+	ret := FindRouteOutput{
+		Route:     route.String(),
+		RouteGUID: route.GUID,
+		Apps:      findApps(s, toFind),
+	}
+	ret.Count = len(ret.Apps)
+
+	output = &ret
+	return
+}
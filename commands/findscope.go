@@ -0,0 +1,152 @@
+package commands
+
+import (
+	"encoding/json"
+	"sort"
+	"sync"
+
+	"github.com/cloudfoundry-community/cfseeker/seeker"
+	"github.com/starkandwayne/goutils/log"
+)
+
+//FindScopeInput contains the information required to find every started app
+// in an org, or in a space within an org
+type FindScopeInput struct {
+	OrgName string
+	//SpaceName limits the search to one space in the org, if given
+	SpaceName string
+	//AllInstances includes instances that aren't running in the output
+	AllInstances bool
+	//Usage includes the resource usage of each running instance in the output
+	Usage bool
+	//Vitals includes the vitals of the VM each running instance is on
+	Vitals bool
+}
+
+//FindScopeOutput contains the return values from a call to FindScope(). There
+// is one entry in Apps for each started app in the org or space.
+type FindScopeOutput struct {
+	OrgName   string       `yaml:"org_name" json:"org_name"`
+	SpaceName string       `yaml:"space_name,omitempty" json:"space_name,omitempty"`
+	Apps      []FindOutput `yaml:"apps" json:"apps"`
+	Count     int          `yaml:"count" json:"count"`
+}
+
+//ReceiveJSON makes FindScopeOutput an implementation of SeekerOutput
+func (f *FindScopeOutput) ReceiveJSON(j []byte) (err error) {
+	err = json.Unmarshal(j, f)
+	return
+}
+
+//FindScope determines the location of every started app in the given org, or
+// in the given space if one is named
+func FindScope(s *seeker.Seeker, in FindScopeInput) (output *FindScopeOutput, err error) {
+	log.Debugf("Beginning evaluation of find command by org or space")
+	if in.OrgName == "" {
+		err = inputErrorf("no org name specified")
+		return
+	}
+	err = validateVitals(s, in.Vitals)
+	if err != nil {
+		return
+	}
+
+	apps, err := s.ListStartedApps(in.OrgName, in.SpaceName)
+	if err != nil {
+		return
+	}
+
+	sort.Sort(scopedAppsByName(apps))
+	var toFind []FindInput
+	for _, app := range apps {
+		toFind = append(toFind, FindInput{AppGUID: app.GUID, AllInstances: in.AllInstances, Usage: in.Usage, Vitals: in.Vitals})
+	}
+
+	ret := FindScopeOutput{
+		OrgName:   in.OrgName,
+		SpaceName: in.SpaceName,
+		Apps:      findApps(s, toFind),
+	}
+	if in.SpaceName == "" {
+		for i := range ret.Apps {
+			ret.Apps[i].SpaceName = apps[i].SpaceName
+		}
+	}
+	ret.Count = len(ret.Apps)
+
+	output = &ret
+	return
+}
+
+//Narrow drops the VM identity of the instances of each app
+func (f *FindScopeOutput) Narrow() {
+	narrowAll(f.Apps)
+}
+
+func narrowAll(apps []FindOutput) {
+	for i := range apps {
+		apps[i].Narrow()
+	}
+}
+
+//Warnings returns the warnings from finding each app
+func (f *FindScopeOutput) Warnings() []string {
+	return warningsOf(f.Apps)
+}
+
+func warningsOf(apps []FindOutput) (ret []string) {
+	for i := range apps {
+		ret = append(ret, apps[i].Warnings()...)
+	}
+	return
+}
+
+//findApps runs Find for each of the given inputs, with at most s.Workers()
+// finds in flight at once. The results are in the same order as the inputs. If
+// a find fails, its entry holds the error instead of the app's instances.
+func findApps(s *seeker.Seeker, inputs []FindInput) []FindOutput {
+	ret := make([]FindOutput, len(inputs))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for i := s.Workers(); i > 0; i-- {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range jobs {
+				in := inputs[idx]
+				found, err := Find(s, in)
+				if err != nil {
+					log.Debugf("Could not find app (GUID: %s, Name: %s): %s", in.AppGUID, in.AppName, err.Error())
+					ret[idx] = FindOutput{
+						AppGUID: in.AppGUID,
+						AppName: in.AppName,
+						Error:   err.Error(),
+					}
+					continue
+				}
+				ret[idx] = *found
+			}
+		}()
+	}
+
+	for i := range inputs {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	return ret
+}
+
+type scopedAppsByName []seeker.ScopedApp
+
+func (a scopedAppsByName) Len() int      { return len(a) }
+func (a scopedAppsByName) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a scopedAppsByName) Less(i, j int) bool {
+	switch {
+	case a[i].OrgName != a[j].OrgName:
+		return a[i].OrgName < a[j].OrgName
+	case a[i].SpaceName != a[j].SpaceName:
+		return a[i].SpaceName < a[j].SpaceName
+	}
+	return a[i].Name < a[j].Name
+}
@@ -0,0 +1,165 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/cloudfoundry-community/cfseeker/seeker"
+	"github.com/starkandwayne/goutils/log"
+)
+
+//FindServiceInput contains the information required to perform the find-service
+// command. Either should be the org, space, and service instance names, or just
+// the service instance GUID
+type FindServiceInput struct {
+	ServiceGUID string
+	OrgName     string
+	SpaceName   string
+	ServiceName string
+}
+
+//FindServiceOutput contains the return values from a call to FindService()
+type FindServiceOutput struct {
+	ServiceGUID   string `yaml:"service_guid" json:"service_guid"`
+	ServiceName   string `yaml:"service_name" json:"service_name"`
+	Type          string `yaml:"type" json:"type"`
+	LastOperation string `yaml:"last_operation,omitempty" json:"last_operation,omitempty"`
+	//Deployment and Director are only given if an on-demand broker made a BOSH
+	// deployment for the service instance
+	Deployment string      `yaml:"deployment,omitempty" json:"deployment,omitempty"`
+	Director   string      `yaml:"director,omitempty" json:"director,omitempty"`
+	VMs        []ServiceVM `yaml:"vms" json:"vms"`
+	//Apps are the apps bound to the service instance
+	Apps  []FindOutput `yaml:"apps" json:"apps"`
+	Count int          `yaml:"count" json:"count"`
+	//warnings are returned in the response metadata instead of the contents
+	warnings []string
+}
+
+//ReceiveJSON makes FindServiceOutput an implementation of SeekerOutput
+func (f *FindServiceOutput) ReceiveJSON(j []byte) (err error) {
+	err = json.Unmarshal(j, f)
+	return
+}
+
+//Narrow drops the VM identity of the instances of each bound app
+func (f *FindServiceOutput) Narrow() {
+	narrowAll(f.Apps)
+}
+
+//Warnings returns the warnings from finding the service instance's deployment
+// and each bound app
+func (f *FindServiceOutput) Warnings() []string {
+	return append(append([]string{}, f.warnings...), warningsOf(f.Apps)...)
+}
+
+//ServiceVM is one BOSH VM in the deployment of a service instance
+type ServiceVM struct {
+	VMName     string   `yaml:"vm_name" json:"vm_name"`
+	InstanceID string   `yaml:"instance_id,omitempty" json:"instance_id,omitempty"`
+	IPs        []string `yaml:"ips" json:"ips"`
+	AZ         string   `yaml:"az,omitempty" json:"az,omitempty"`
+	JobState   string   `yaml:"job_state,omitempty" json:"job_state,omitempty"`
+}
+
+//FindService determines the VMs that back the given service instance, if it was
+// made by an on-demand broker, and the location of the apps bound to it
+func FindService(s *seeker.Seeker, in FindServiceInput) (output *FindServiceOutput, err error) {
+	log.Debugf("Beginning evaluation of find-service command")
+	if in.ServiceGUID == "" && (in.OrgName == "" || in.SpaceName == "" || in.ServiceName == "") {
+		err = inputErrorf("Either the service instance GUID or the org, space, and service instance names must be given")
+		return
+	}
+	if in.ServiceGUID != "" && (in.OrgName != "" || in.SpaceName != "" || in.ServiceName != "") {
+		err = inputErrorf("The org, space, and service instance names cannot be given along with a service instance GUID")
+		return
+	}
+
+	if !s.BOSHConfigured() {
+		err = inputErrorf("BOSH must be configured to find the VMs of a service instance")
+		return
+	}
+
+	var instance *seeker.ServiceInstanceMeta
+	if in.ServiceGUID != "" {
+		instance, err = s.ServiceInstanceByGUID(in.ServiceGUID)
+	} else {
+		instance, err = s.ServiceInstanceByName(in.OrgName, in.SpaceName, in.ServiceName)
+	}
+	if err != nil {
+		return
+	}
+
+	ret := FindServiceOutput{
+		ServiceGUID:   instance.GUID,
+		ServiceName:   instance.Name,
+		Type:          instance.Type,
+		LastOperation: instance.LastOperation,
+		VMs:           []ServiceVM{},
+		Apps:          []FindOutput{},
+	}
+
+	deployment := seeker.ServiceDeployment(instance.GUID)
+	director, vms, err := s.GetDeploymentVMs(deployment)
+	if err != nil {
+		err = fmt.Errorf("Error while getting VMs of service instance: %s", err.Error())
+		return
+	}
+	if director != "" {
+		ret.Deployment = deployment
+		ret.Director = director
+		ret.VMs = append(ret.VMs, serviceVMs(vms)...)
+	} else {
+		ret.warnings = append(ret.warnings, fmt.Sprintf("No BOSH director has deployment `%s`, so service instance `%s` was not made by an on-demand broker, or its deployment is on a director that isn't configured", deployment, instance.Name))
+	}
+
+	appGUIDs, err := s.BoundAppGUIDs(instance.GUID)
+	if err != nil {
+		return
+	}
+	toFind := make([]FindInput, 0, len(appGUIDs))
+	for _, guid := range appGUIDs {
+		toFind = append(toFind, FindInput{AppGUID: guid})
+	}
+	ret.Apps = append(ret.Apps, findApps(s, toFind)...)
+	ret.Count = len(ret.Apps)
+
+	output = &ret
+	return
+}
+
+//serviceVMs gathers the cache entries for each IP of the same VM into one
+// ServiceVM, ordered by job name and index
+func serviceVMs(vms []*seeker.VMInfo) (ret []ServiceVM) {
+	sort.Sort(vmInfosByName(vms))
+	for _, vm := range vms {
+		name := fmt.Sprintf("%s/%d", vm.JobName, vm.Index)
+		if len(ret) > 0 && ret[len(ret)-1].VMName == name {
+			ret[len(ret)-1].IPs = append(ret[len(ret)-1].IPs, vm.IP)
+			continue
+		}
+		ret = append(ret, ServiceVM{
+			VMName:     name,
+			InstanceID: vm.ID,
+			IPs:        []string{vm.IP},
+			AZ:         vm.AZ,
+			JobState:   vm.JobState,
+		})
+	}
+	return
+}
+
+type vmInfosByName []*seeker.VMInfo
+
+func (v vmInfosByName) Len() int      { return len(v) }
+func (v vmInfosByName) Swap(i, j int) { v[i], v[j] = v[j], v[i] }
+func (v vmInfosByName) Less(i, j int) bool {
+	switch {
+	case v[i].JobName != v[j].JobName:
+		return v[i].JobName < v[j].JobName
+	case v[i].Index != v[j].Index:
+		return v[i].Index < v[j].Index
+	}
+	return v[i].IP < v[j].IP
+}
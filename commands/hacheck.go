@@ -0,0 +1,152 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cloudfoundry-community/cfseeker/seeker"
+	"github.com/starkandwayne/goutils/log"
+)
+
+//HACheckInput contains the information required to perform the ha-check
+// command. Either one app is named, the same way as for Find, or just an org
+// (and optionally a space) is given to check every started app in it.
+type HACheckInput struct {
+	AppGUID   string
+	OrgName   string
+	SpaceName string
+	AppName   string
+}
+
+//HACheckOutput contains the return values from a call to HACheck()
+type HACheckOutput struct {
+	Apps  []HACheckApp `yaml:"apps" json:"apps"`
+	Count int          `yaml:"count" json:"count"`
+	//AtRisk is the number of apps which have at least one problem
+	AtRisk int `yaml:"at_risk" json:"at_risk"`
+}
+
+//ReceiveJSON makes HACheckOutput an implementation of SeekerOutput
+func (h *HACheckOutput) ReceiveJSON(j []byte) (err error) {
+	err = json.Unmarshal(j, h)
+	return
+}
+
+//HACheckApp describes how the running instances of one app are spread across
+// cells and availability zones
+type HACheckApp struct {
+	AppGUID          string `yaml:"guid" json:"guid"`
+	AppName          string `yaml:"name" json:"name"`
+	SpaceName        string `yaml:"space_name,omitempty" json:"space_name,omitempty"`
+	RunningInstances int    `yaml:"running_instances" json:"running_instances"`
+	//Cells maps each cell the app runs on to how many of its instances are there.
+	// Cells are named by deployment and VM name if BOSH is configured, and by IP
+	// otherwise.
+	Cells map[string]int `yaml:"cells" json:"cells"`
+	//AZs maps each AZ the app runs in to how many of its instances are there. It
+	// is left out if the AZ of any instance couldn't be determined.
+	AZs      map[string]int `yaml:"azs,omitempty" json:"azs,omitempty"`
+	AtRisk   bool           `yaml:"at_risk" json:"at_risk"`
+	Problems []string       `yaml:"problems,omitempty" json:"problems,omitempty"`
+	Error    string         `yaml:"error,omitempty" json:"error,omitempty"`
+}
+
+//HACheck reports how the running instances of the given app, or of every app
+// in the given org or space, are spread across cells and AZs. Apps that would
+// go entirely down if one cell or one AZ were lost are flagged as at risk.
+func HACheck(s *seeker.Seeker, in HACheckInput) (output *HACheckOutput, err error) {
+	log.Debugf("Beginning evaluation of ha-check command")
+	var apps []FindOutput
+	if in.AppGUID == "" && in.AppName == "" && in.OrgName != "" {
+		var found *FindScopeOutput
+		found, err = FindScope(s, FindScopeInput{OrgName: in.OrgName, SpaceName: in.SpaceName})
+		if err != nil {
+			return
+		}
+		apps = found.Apps
+	} else {
+		var found *FindOutput
+		found, err = Find(s, FindInput{
+			AppGUID:   in.AppGUID,
+			OrgName:   in.OrgName,
+			SpaceName: in.SpaceName,
+			AppName:   in.AppName,
+		})
+		if err != nil {
+			return
+		}
+		apps = []FindOutput{*found}
+	}
+
+	ret := HACheckOutput{Apps: make([]HACheckApp, len(apps))}
+	for i, app := range apps {
+		ret.Apps[i] = checkSpread(app)
+		if ret.Apps[i].AtRisk {
+			ret.AtRisk++
+		}
+	}
+	ret.Count = len(ret.Apps)
+
+	output = &ret
+	return
+}
+
+//checkSpread works out the cell and AZ spread of the instances in the given
+// find result, and notes the problems with it
+func checkSpread(app FindOutput) (ret HACheckApp) {
+	ret = HACheckApp{
+		AppGUID:   app.AppGUID,
+		AppName:   app.AppName,
+		SpaceName: app.SpaceName,
+		Cells:     map[string]int{},
+		AZs:       map[string]int{},
+		Error:     app.Error,
+	}
+	if app.Error != "" {
+		ret.AZs = nil
+		return
+	}
+
+	for _, inst := range app.Instances {
+		if inst.Host == "" {
+			continue
+		}
+		ret.RunningInstances++
+
+		cell := inst.Host
+		if inst.VMName != "" {
+			cell = inst.Deployment + "/" + inst.VMName
+		}
+		ret.Cells[cell]++
+
+		if ret.AZs != nil {
+			if inst.AZ == "" {
+				ret.AZs = nil
+			} else {
+				ret.AZs[inst.AZ]++
+			}
+		}
+	}
+
+	switch ret.RunningInstances {
+	case 0:
+		ret.Problems = append(ret.Problems, "no instances are running")
+		ret.AZs = nil
+	case 1:
+		ret.Problems = append(ret.Problems, "only one instance is running")
+	default:
+		if len(ret.Cells) == 1 {
+			for cell := range ret.Cells {
+				ret.Problems = append(ret.Problems, fmt.Sprintf("all instances are on cell `%s`", cell))
+			}
+		}
+		if len(ret.AZs) == 1 {
+			for az := range ret.AZs {
+				ret.Problems = append(ret.Problems, fmt.Sprintf("all instances are in AZ `%s`", az))
+			}
+		}
+	}
+
+	ret.AtRisk = len(ret.Problems) > 0
+	return
+}
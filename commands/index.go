@@ -0,0 +1,23 @@
+package commands
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cloudfoundry-community/cfseeker/seeker"
+)
+
+//instanceIndex returns the index kept by the seeker's crawler if there is a
+// fresh one, or else a recently built index, along with when it was built
+func instanceIndex(s *seeker.Seeker) (index *seeker.InstanceIndex, indexedAt string, err error) {
+	index, err = s.RecentIndex()
+	if err != nil {
+		err = fmt.Errorf("Error while building instance index: %s", err.Error())
+		return
+	}
+	return index, formatIndexTime(index.BuiltAt), nil
+}
+
+func formatIndexTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,135 @@
+package commands
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cloudfoundry-community/cfseeker/seeker"
+	"github.com/starkandwayne/goutils/log"
+)
+
+//ListInput contains the information required to perform the list command
+type ListInput struct {
+	//VMName is the name of the BOSH VM to list app instances for, in the form
+	// <jobname>/<index>
+	VMName string
+	//Deployment is the BOSH deployment that the VM is in. If not given, VMs with
+	// the given name in every configured deployment are listed.
+	Deployment string
+}
+
+//ListOutput contains the return values from a call to List()
+type ListOutput struct {
+	VMName    string         `yaml:"vm_name" json:"vm_name"`
+	Instances []ListInstance `yaml:"instances" json:"instances"`
+	Count     int            `yaml:"count" json:"count"`
+	//IndexedAt is when the index this was answered from was built, either by the
+	// crawler or on demand
+	IndexedAt string `yaml:"indexed_at,omitempty" json:"indexed_at,omitempty"`
+}
+
+//ReceiveJSON makes ListOutput an implementation of SeekerOutput
+func (l *ListOutput) ReceiveJSON(j []byte) (err error) {
+	err = json.Unmarshal(j, l)
+	return
+}
+
+//ListInstance represents one app instance running on the listed VM
+type ListInstance struct {
+	OrgName        string       `yaml:"org_name" json:"org_name"`
+	SpaceName      string       `yaml:"space_name" json:"space_name"`
+	AppName        string       `yaml:"app_name" json:"app_name"`
+	AppGUID        string       `yaml:"app_guid" json:"app_guid"`
+	InstanceNumber int          `yaml:"number" json:"number"`
+	Deployment     string       `yaml:"deployment" json:"deployment"`
+	Director       string       `yaml:"director" json:"director"`
+	Host           string       `yaml:"host" json:"host"`
+	Port           InstancePort `yaml:"port" json:"port"`
+}
+
+//ParseVMName splits a BOSH VM name of the form <jobname>/<index> into its job
+// name and index.
+func ParseVMName(name string) (job string, index int, err error) {
+	parts := strings.Split(name, "/")
+	if len(parts) != 2 || parts[0] == "" {
+		err = inputErrorf("VM name `%s` is not of the form <jobname>/<index>", name)
+		return
+	}
+
+	index, err = strconv.Atoi(parts[1])
+	if err != nil {
+		err = inputErrorf("VM index `%s` is not a number", parts[1])
+		return
+	}
+
+	return parts[0], index, nil
+}
+
+//List returns every app instance running on the given BOSH VM
+func List(s *seeker.Seeker, in ListInput) (output *ListOutput, err error) {
+	log.Debugf("Beginning evaluation of list command")
+	job, index, err := ParseVMName(in.VMName)
+	if err != nil {
+		return
+	}
+
+	if !s.BOSHConfigured() {
+		err = inputErrorf("BOSH must be configured to list the instances on a VM")
+		return
+	}
+
+	vms, err := s.GetVMsWithName(in.Deployment, job, index)
+	if err != nil {
+		return
+	}
+	if len(vms) == 0 {
+		err = notFoundErrorf("Could not find VM `%s` in the configured deployments", in.VMName)
+		return
+	}
+
+	instIndex, indexedAt, err := instanceIndex(s)
+	if err != nil {
+		return
+	}
+
+	ret := ListOutput{VMName: in.VMName, IndexedAt: indexedAt}
+	for _, vm := range vms {
+		for _, inst := range instIndex.OnHost(vm.IP) {
+			ret.Instances = append(ret.Instances, ListInstance{
+				OrgName:        inst.OrgName,
+				SpaceName:      inst.SpaceName,
+				AppName:        inst.App.Name,
+				AppGUID:        inst.App.GUID,
+				InstanceNumber: inst.Index,
+				Deployment:     vm.DeploymentName,
+				Director:       vm.Director,
+				Host:           inst.Host,
+				Port:           InstancePort(inst.Port),
+			})
+		}
+	}
+
+	sort.Sort(listInstancesByLocation(ret.Instances))
+	ret.Count = len(ret.Instances)
+
+	output = &ret
+	return
+}
+
+type listInstancesByLocation []ListInstance
+
+func (l listInstancesByLocation) Len() int      { return len(l) }
+func (l listInstancesByLocation) Swap(i, j int) { l[i], l[j] = l[j], l[i] }
+func (l listInstancesByLocation) Less(i, j int) bool {
+	switch {
+	case l[i].OrgName != l[j].OrgName:
+		return l[i].OrgName < l[j].OrgName
+	case l[i].SpaceName != l[j].SpaceName:
+		return l[i].SpaceName < l[j].SpaceName
+	case l[i].AppName != l[j].AppName:
+		return l[i].AppName < l[j].AppName
+	}
+	return l[i].InstanceNumber < l[j].InstanceNumber
+}
@@ -0,0 +1,35 @@
+package commands
+
+import "testing"
+
+func TestParseVMName(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		job   string
+		index int
+		valid bool
+	}{
+		{"diego_cell/3", "diego_cell", 3, true},
+		{"router/0", "router", 0, true},
+		{"diego_cell", "", 0, false},
+		{"/3", "", 0, false},
+		{"diego_cell/three", "", 0, false},
+		{"diego_cell/3/4", "", 0, false},
+		{"", "", 0, false},
+	} {
+		job, index, err := ParseVMName(test.name)
+		if !test.valid {
+			if _, isInputError := err.(InputError); !isInputError {
+				t.Errorf("%q: expected an input error, got %v", test.name, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: unexpected error: %s", test.name, err)
+			continue
+		}
+		if job != test.job || index != test.index {
+			t.Errorf("%q: expected %s/%d, got %s/%d", test.name, test.job, test.index, job, index)
+		}
+	}
+}
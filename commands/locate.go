@@ -0,0 +1,161 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/cloudfoundry-community/cfseeker/seeker"
+	"github.com/starkandwayne/goutils/log"
+)
+
+//LocateInput contains the information required to perform the locate command.
+// Either the app GUID or the app name should be given.
+type LocateInput struct {
+	AppGUID string
+	AppName string
+	//Foundations lists the names of the foundations to search, in the order
+	// their matches should be given
+	Foundations []string
+}
+
+//LocateOutput contains the return values from a call to Locate()
+type LocateOutput struct {
+	Matches []LocateMatch `yaml:"matches" json:"matches"`
+	Count   int           `yaml:"count" json:"count"`
+	//Errors maps the name of each foundation which couldn't be searched to the
+	// reason why
+	Errors map[string]string `yaml:"errors,omitempty" json:"errors,omitempty"`
+}
+
+//ReceiveJSON makes LocateOutput an implementation of SeekerOutput
+func (l *LocateOutput) ReceiveJSON(j []byte) (err error) {
+	err = json.Unmarshal(j, l)
+	return
+}
+
+//Narrow drops the VM identity of the instances of each match
+func (l *LocateOutput) Narrow() {
+	for i := range l.Matches {
+		l.Matches[i].Narrow()
+	}
+}
+
+//Warnings returns the warnings from finding each match, prefixed with the name
+// of its foundation
+func (l *LocateOutput) Warnings() (ret []string) {
+	for i := range l.Matches {
+		for _, warning := range l.Matches[i].Warnings() {
+			ret = append(ret, fmt.Sprintf("%s: %s", l.Matches[i].Foundation, warning))
+		}
+	}
+	return
+}
+
+//LocateMatch is an app found by Locate. The location of its instances is only
+// given if the app is started.
+type LocateMatch struct {
+	Foundation string `yaml:"foundation" json:"foundation"`
+	OrgName    string `yaml:"org_name" json:"org_name"`
+	State      string `yaml:"state" json:"state"`
+	FindOutput `yaml:",inline"`
+}
+
+//Locate searches each of the given foundations at once for apps with the given
+// GUID or name, and reports the org, space, and instance locations of each. A
+// foundation whose seeker is nil couldn't be connected to, and is reported as
+// an error.
+func Locate(seekers map[string]*seeker.Seeker, in LocateInput) (output *LocateOutput, err error) {
+	log.Debugf("Beginning evaluation of locate command")
+	if (in.AppGUID == "") == (in.AppName == "") {
+		err = inputErrorf("exactly one of an app GUID or an app name must be given")
+		return
+	}
+
+	for _, name := range in.Foundations {
+		if _, found := seekers[name]; !found {
+			err = inputErrorf("No foundation named `%s` is configured", name)
+			return
+		}
+	}
+
+	matches := make([][]LocateMatch, len(in.Foundations))
+	errs := make([]error, len(in.Foundations))
+	var wg sync.WaitGroup
+	for i, name := range in.Foundations {
+		s := seekers[name]
+		if s == nil {
+			errs[i] = fmt.Errorf("Could not connect to foundation")
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, name string, s *seeker.Seeker) {
+			defer wg.Done()
+			matches[i], errs[i] = locateIn(s, name, in)
+		}(i, name, s)
+	}
+	wg.Wait()
+
+	ret := LocateOutput{Matches: []LocateMatch{}}
+	for i, name := range in.Foundations {
+		if errs[i] != nil {
+			log.Debugf("Could not search foundation `%s`: %s", name, errs[i].Error())
+			if ret.Errors == nil {
+				ret.Errors = map[string]string{}
+			}
+			ret.Errors[name] = errs[i].Error()
+			continue
+		}
+		ret.Matches = append(ret.Matches, matches[i]...)
+	}
+	ret.Count = len(ret.Matches)
+
+	output = &ret
+	return
+}
+
+//locateIn looks for the app in one foundation
+func locateIn(s *seeker.Seeker, foundation string, in LocateInput) (ret []LocateMatch, err error) {
+	var apps []seeker.ScopedApp
+	if in.AppGUID != "" {
+		var app seeker.ScopedApp
+		var found bool
+		app, found, err = s.FindAppWithGUID(in.AppGUID)
+		if err != nil || !found {
+			return
+		}
+		apps = []seeker.ScopedApp{app}
+	} else {
+		apps, err = s.FindAppsNamed(in.AppName)
+		if err != nil {
+			return
+		}
+	}
+	sort.Sort(scopedAppsByName(apps))
+
+	var toFind []FindInput
+	for _, app := range apps {
+		if app.State == "STARTED" {
+			toFind = append(toFind, FindInput{AppGUID: app.GUID})
+		}
+	}
+	found := findApps(s, toFind)
+
+	for _, app := range apps {
+		match := LocateMatch{
+			Foundation: foundation,
+			OrgName:    app.OrgName,
+			State:      app.State,
+			FindOutput: FindOutput{AppGUID: app.GUID, AppName: app.Name},
+		}
+		if app.State == "STARTED" {
+			match.FindOutput = found[0]
+			found = found[1:]
+		}
+		match.SpaceName = app.SpaceName
+		ret = append(ret, match)
+	}
+	return
+}
@@ -0,0 +1,76 @@
+package commands
+
+import (
+	"encoding/json"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/cloudfoundry-community/cfseeker/seeker"
+	"github.com/starkandwayne/goutils/log"
+)
+
+//SearchInput contains the information required to search for apps by name
+type SearchInput struct {
+	//Pattern is matched against app names ignoring case. If it contains *, ?, or
+	// [ it is a glob that must match the whole name. Otherwise, names containing
+	// it match.
+	Pattern string
+}
+
+//SearchOutput contains the return values from a call to Search(). There is one
+// entry in Apps for each app whose name matches, in any org or space.
+type SearchOutput struct {
+	Pattern string      `yaml:"pattern" json:"pattern"`
+	Apps    []SearchApp `yaml:"apps" json:"apps"`
+	Count   int         `yaml:"count" json:"count"`
+}
+
+//SearchApp is an app whose name matched a search
+type SearchApp struct {
+	AppGUID   string `yaml:"guid" json:"guid"`
+	AppName   string `yaml:"name" json:"name"`
+	OrgName   string `yaml:"org_name" json:"org_name"`
+	SpaceName string `yaml:"space_name" json:"space_name"`
+	State     string `yaml:"state" json:"state"`
+}
+
+//ReceiveJSON makes SearchOutput an implementation of SeekerOutput
+func (o *SearchOutput) ReceiveJSON(j []byte) (err error) {
+	err = json.Unmarshal(j, o)
+	return
+}
+
+//Search lists the apps, across every org, whose names match the given pattern
+func Search(s *seeker.Seeker, in SearchInput) (output *SearchOutput, err error) {
+	log.Debugf("Beginning evaluation of search command")
+	if in.Pattern == "" {
+		err = inputErrorf("no search pattern specified")
+		return
+	}
+	if _, matchErr := path.Match(strings.ToLower(in.Pattern), ""); matchErr != nil {
+		err = inputErrorf("invalid search pattern `%s`: %s", in.Pattern, matchErr.Error())
+		return
+	}
+
+	apps, err := s.SearchApps(in.Pattern)
+	if err != nil {
+		return
+	}
+	sort.Sort(scopedAppsByName(apps))
+
+	ret := SearchOutput{Pattern: in.Pattern, Apps: []SearchApp{}}
+	for _, app := range apps {
+		ret.Apps = append(ret.Apps, SearchApp{
+			AppGUID:   app.GUID,
+			AppName:   app.Name,
+			OrgName:   app.OrgName,
+			SpaceName: app.SpaceName,
+			State:     app.State,
+		})
+	}
+	ret.Count = len(ret.Apps)
+
+	output = &ret
+	return
+}
@@ -0,0 +1,140 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/cloudfoundry-community/cfseeker/seeker"
+	"github.com/starkandwayne/goutils/log"
+)
+
+//WhoisInput contains the information required to perform the whois command.
+// Either the host and port or the container IP should be given.
+type WhoisInput struct {
+	//Host is the IP address of the backend to look up
+	Host string
+	//Port is the port on the host that the app instance is listening on
+	Port string
+	//ContainerIP is the overlay IP address of the container of the app instance
+	// to look up. Looking instances up this way requires the v3 CF backend.
+	ContainerIP string
+}
+
+//WhoisOutput contains the return values from a call to Whois(). The names and
+// GUIDs of the owning app, space and org are given the same way as they are by
+// Convert.
+type WhoisOutput struct {
+	ConvertOutput  `yaml:",inline"`
+	InstanceNumber int          `yaml:"number" json:"number"`
+	ProcessType    string       `yaml:"process_type,omitempty" json:"process_type,omitempty"`
+	VMName         string       `yaml:"vm_name,omitempty" json:"vm_name,omitempty"`
+	Deployment     string       `yaml:"deployment,omitempty" json:"deployment,omitempty"`
+	Director       string       `yaml:"director,omitempty" json:"director,omitempty"`
+	Host           string       `yaml:"host" json:"host"`
+	Port           InstancePort `yaml:"port" json:"port"`
+	ContainerIP    string       `yaml:"container_ip,omitempty" json:"container_ip,omitempty"`
+	//IndexedAt is when the index this was answered from was built, either by the
+	// crawler or on demand
+	IndexedAt string `yaml:"indexed_at,omitempty" json:"indexed_at,omitempty"`
+}
+
+//ReceiveJSON makes WhoisOutput an implementation of SeekerOutput
+func (w *WhoisOutput) ReceiveJSON(j []byte) (err error) {
+	err = json.Unmarshal(j, w)
+	return
+}
+
+//Whois determines which app instance is listening on the given host and port
+func Whois(s *seeker.Seeker, in WhoisInput) (output *WhoisOutput, err error) {
+	log.Debugf("Beginning evaluation of whois command")
+	var port int
+	if in.ContainerIP != "" {
+		if in.Host != "" || in.Port != "" {
+			err = inputErrorf("A host and port cannot be given along with a container IP")
+			return
+		}
+		if net.ParseIP(in.ContainerIP) == nil {
+			err = inputErrorf("Container IP `%s` is not an IP address", in.ContainerIP)
+			return
+		}
+	} else {
+		if in.Host == "" || in.Port == "" {
+			err = inputErrorf("Both a host and a port, or a container IP, must be given")
+			return
+		}
+		if net.ParseIP(in.Host) == nil {
+			err = inputErrorf("Host `%s` is not an IP address", in.Host)
+			return
+		}
+
+		port, err = strconv.Atoi(in.Port)
+		if err != nil {
+			err = inputErrorf("Port `%s` is not a number", in.Port)
+			return
+		}
+	}
+
+	index, indexedAt, err := instanceIndex(s)
+	if err != nil {
+		return
+	}
+
+	var inst seeker.IndexedInstance
+	var found bool
+	if in.ContainerIP != "" {
+		inst, found = index.WithInternalIP(in.ContainerIP)
+		if !found {
+			err = notFoundErrorf("No running app instance found with container IP `%s`", in.ContainerIP)
+			if !index.KnowsInternalIPs() {
+				err = notFoundErrorf("%s. Container IPs are only known when the v3 CF backend is in use", err.Error())
+			}
+			return
+		}
+		port = inst.Port
+	} else {
+		inst, found = index.At(in.Host, port)
+		if !found {
+			err = notFoundErrorf("No running app instance found listening on `%s:%d`", in.Host, port)
+			return
+		}
+	}
+
+	ret := WhoisOutput{
+		ConvertOutput: ConvertOutput{
+			OrgGUID:   inst.OrgGUID,
+			OrgName:   inst.OrgName,
+			SpaceGUID: inst.SpaceGUID,
+			SpaceName: inst.SpaceName,
+			AppGUID:   inst.App.GUID,
+			AppName:   inst.App.Name,
+			Type:      ConvertTypeApp,
+		},
+		InstanceNumber: inst.Index,
+		ProcessType:    inst.ProcessType,
+		Host:           inst.Host,
+		Port:           InstancePort(port),
+		ContainerIP:    inst.InternalIP,
+		IndexedAt:      indexedAt,
+	}
+
+	if s.BOSHConfigured() {
+		log.Debugf("Looking up VM with IP: %s", inst.Host)
+		var vm *seeker.VMInfo
+		vm, err = s.GetVMWithIP(inst.Host)
+		if err != nil {
+			err = fmt.Errorf("Error while translating VM name for IP `%s`: %s", inst.Host, err.Error())
+			return
+		}
+
+		if vm != nil {
+			ret.Deployment = vm.DeploymentName
+			ret.Director = vm.Director
+			ret.VMName = fmt.Sprintf("%s/%d", vm.JobName, vm.Index)
+		}
+	}
+
+	output = &ret
+	return
+}
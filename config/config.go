@@ -1,5 +1,7 @@
 package config
 
+import "fmt"
+
 //Version of cfseeker gets set here
 var Version = ""
 
@@ -9,12 +11,29 @@ func init() {
 	}
 }
 
+//DefaultFoundationName is the name given to the foundation described by the
+// top-level cf and bosh sections when no foundations are listed
+const DefaultFoundationName = "default"
+
 //Config contains all the information needed for the seeker backend to operate
 type Config struct {
 	CF          CFConfig     `yaml:"cf"`
 	BOSH        BOSHConfig   `yaml:"bosh"`
 	Server      ServerConfig `yaml:"server"`
 	HTTPTimeout int          `yaml:"http_timeout"`
+	Vitals      VitalsConfig `yaml:"vitals"`
+	//Foundations lists the Cloud Foundries to seek apps in. If given, the
+	// top-level cf and bosh sections are not used, and the first foundation
+	// listed is the default one.
+	Foundations []FoundationConfig `yaml:"foundations"`
+}
+
+//FoundationConfig names a Cloud Foundry and, optionally, the BOSH that deploys
+// it
+type FoundationConfig struct {
+	Name string     `yaml:"name"`
+	CF   CFConfig   `yaml:"cf"`
+	BOSH BOSHConfig `yaml:"bosh"`
 }
 
 //CFConfig contains location and authorization info about a target Cloud Foundry
@@ -23,18 +42,112 @@ type CFConfig struct {
 	ClientID          string `yaml:"client_id"`
 	ClientSecret      string `yaml:"client_secret"`
 	SkipSSLValidation bool   `yaml:"skip_ssl_validation"`
+	//Concurrency is how many app stats requests are made at once when finding
+	// many apps, and how many GUID lookups are made at once. Defaults to 8.
+	Concurrency int `yaml:"concurrency"`
+	//Backend is the version of the CF API to look up app instances with, either
+	// v2 or v3. Defaults to v2. With v3, the instances of every process type are
+	// found, and v2 is used if the CF API doesn't support v3.
+	Backend string `yaml:"backend"`
 }
 
-//BOSHConfig contains location, auth, and tracking info for your BOSH.
+//BOSHConfig lists the BOSH directors which deploy a foundation. In the config
+// file, it is given as a single director, as a list of directors, or as a
+// mapping with the list of directors under directors.
 type BOSHConfig struct {
-	APIAddress        string   `yaml:"api_address"`
-	Username          string   `yaml:"username"`
-	Password          string   `yaml:"password"`
-	ClientID          string   `yaml:"client_id"`
-	ClientSecret      string   `yaml:"client_secret"`
-	SkipSSLValidation bool     `yaml:"skip_ssl_validation"`
-	Deployments       []string `yaml:"deployments"`
-	SkipBOSH          bool     `yaml:"skip_bosh"`
+	Directors []BOSHDirectorConfig `yaml:"directors"`
+	SkipBOSH  bool                 `yaml:"skip_bosh"`
+}
+
+//BOSHDirectorConfig contains location, auth, and tracking info for one BOSH
+// director.
+type BOSHDirectorConfig struct {
+	//Name identifies the director in find output. Defaults to the API address.
+	Name              string `yaml:"name"`
+	APIAddress        string `yaml:"api_address"`
+	Username          string `yaml:"username"`
+	Password          string `yaml:"password"`
+	ClientID          string `yaml:"client_id"`
+	ClientSecret      string `yaml:"client_secret"`
+	SkipSSLValidation bool   `yaml:"skip_ssl_validation"`
+	//CACert is a PEM encoded certificate to trust when connecting to the director
+	CACert      string   `yaml:"ca_cert"`
+	Deployments []string `yaml:"deployments"`
+	//Discover turns on discovery of the deployments on the director. Discovered
+	// deployments are searched along with those listed in Deployments.
+	Discover *BOSHDiscoveryConfig `yaml:"discover"`
+	//IsolationSegments maps the names of CF isolation segments to the
+	// deployments on this director whose cells host them
+	IsolationSegments map[string]string `yaml:"isolation_segments"`
+}
+
+//BOSHDiscoveryConfig says which of the deployments on a BOSH director should be
+// searched, and how often to check the director for new ones. Every deployment
+// is searched if no filters are given.
+type BOSHDiscoveryConfig struct {
+	//Include lists regexes, one of which deployment names must match, if given
+	Include []string `yaml:"include"`
+	//Exclude lists regexes which deployment names must not match
+	Exclude []string `yaml:"exclude"`
+	//Releases lists release names, one of which deployments must use, if given
+	Releases []string `yaml:"releases"`
+	Interval int      `yaml:"interval"` //in seconds. Defaults to 10 minutes
+}
+
+//UnmarshalYAML lets the bosh section be given either as a list of directors or,
+// as it was before multiple directors were supported, as one director. To give
+// skip_bosh along with a list of directors, put the list under directors.
+func (b *BOSHConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var directors []BOSHDirectorConfig
+	if err := unmarshal(&directors); err == nil {
+		b.Directors = directors
+	} else {
+		var mapping struct {
+			BOSHDirectorConfig `yaml:",inline"`
+			Directors          []BOSHDirectorConfig `yaml:"directors"`
+			SkipBOSH           bool                 `yaml:"skip_bosh"`
+		}
+		if err := unmarshal(&mapping); err != nil {
+			return err
+		}
+		if mapping.Directors != nil {
+			if mapping.APIAddress != "" {
+				return fmt.Errorf("bosh cannot give both a list of directors and the api_address of a single director")
+			}
+			b.Directors = mapping.Directors
+		} else {
+			b.Directors = []BOSHDirectorConfig{mapping.BOSHDirectorConfig}
+		}
+		b.SkipBOSH = mapping.SkipBOSH
+	}
+
+	for i := range b.Directors {
+		if b.Directors[i].Name == "" {
+			b.Directors[i].Name = b.Directors[i].APIAddress
+		}
+	}
+	return nil
+}
+
+//Configured returns true if the director has all the keys required to attempt
+// a connection to it. False otherwise.
+func (d BOSHDirectorConfig) Configured() bool {
+	return d.APIAddress != "" &&
+		(len(d.Deployments) > 0 || d.Discover != nil) &&
+		((d.Username != "" && d.Password != "") || (d.ClientID != "" && d.ClientSecret != ""))
+}
+
+//VitalsConfig has the thresholds at or above which the vitals of a BOSH VM are
+// highlighted. Percentages are out of 100. A threshold of zero isn't checked.
+type VitalsConfig struct {
+	//CPUPercent is checked against the sum of user, sys, and wait CPU
+	CPUPercent  float64 `yaml:"cpu_percent"`
+	MemPercent  float64 `yaml:"mem_percent"`
+	SwapPercent float64 `yaml:"swap_percent"`
+	//DiskPercent is checked against each disk of the VM
+	DiskPercent float64 `yaml:"disk_percent"`
+	//Load is checked against the 1 minute load average
+	Load float64 `yaml:"load"`
 }
 
 //ServerConfig has the info needed specifically for running in server mode
@@ -43,6 +156,30 @@ type ServerConfig struct {
 	Port      int             `yaml:"port"`
 	NoAuth    bool            `yaml:"no_auth"`
 	CacheTTL  int             `yaml:"cache_ttl"` //in seconds
+	//ListingTTL is how long (in seconds) the org, space, and app listings used to
+	// complete names are kept
+	ListingTTL int `yaml:"listing_ttl"`
+	//CFCacheTTL is how long (in seconds) the names and GUIDs of orgs, spaces, and
+	// apps looked up from the CF API are kept. Zero turns the cache off.
+	CFCacheTTL int `yaml:"cf_cache_ttl"`
+	//CrawlInterval is how often (in seconds) the background crawler rebuilds the
+	// app instance index. The crawler is disabled if this is zero.
+	CrawlInterval int `yaml:"crawl_interval"`
+	//CrawlConcurrency is how many app stats requests the crawler makes at once.
+	// Defaults to the CF concurrency.
+	CrawlConcurrency int `yaml:"crawl_concurrency"`
+	//CrawlStaleness is how old (in seconds) the index can be before requests stop
+	// being answered from it. Defaults to twice the crawl interval.
+	CrawlStaleness int `yaml:"crawl_staleness"`
+	//IndexTTL is how long (in seconds) an instance index built on demand, when
+	// the crawler has no fresh index, is reused by list and whois
+	IndexTTL int `yaml:"index_ttl"`
+	//CacheFile is where the BOSH VM cache is saved, so that it can be loaded
+	// again when the server restarts. The cache isn't saved if this is empty.
+	CacheFile string `yaml:"cache_file"`
+	//CacheSaveInterval is how often (in seconds) the BOSH VM cache is saved to
+	// the cache file. It is always saved when the server shuts down.
+	CacheSaveInterval int `yaml:"cache_save_interval"`
 }
 
 //BasicAuthConfig lets you set up basic auth for your API
@@ -55,3 +192,88 @@ type BasicAuthConfig struct {
 func (c *Config) SkipBOSH() {
 	c.BOSH.SkipBOSH = true
 }
+
+//FoundationNames returns the names of the configured foundations, with the
+// default foundation first
+func (c *Config) FoundationNames() (ret []string) {
+	if len(c.Foundations) == 0 {
+		return []string{DefaultFoundationName}
+	}
+
+	for _, f := range c.Foundations {
+		ret = append(ret, f.Name)
+	}
+	return
+}
+
+//Foundation returns a copy of the config whose cf and bosh sections are those
+// of the foundation with the given name, and which lists no other foundations.
+// If the name is empty, the default foundation is used.
+func (c *Config) Foundation(name string) (*Config, error) {
+	ret := *c
+	ret.Foundations = nil
+	if len(c.Foundations) == 0 {
+		if name != "" && name != DefaultFoundationName {
+			return nil, fmt.Errorf("No foundation named `%s` is configured", name)
+		}
+		return &ret, nil
+	}
+
+	if name == "" {
+		name = c.Foundations[0].Name
+	}
+
+	for _, f := range c.Foundations {
+		if f.Name == name {
+			ret.CF = f.CF
+			ret.BOSH = f.BOSH
+			return &ret, nil
+		}
+	}
+	return nil, fmt.Errorf("No foundation named `%s` is configured", name)
+}
+
+//ValidateFoundations checks that every listed foundation has a unique, non-empty
+// name, and that no two BOSH directors in a foundation share a name
+func (c *Config) ValidateFoundations() error {
+	err := c.BOSH.validateDirectors()
+	if err != nil {
+		return err
+	}
+
+	seen := map[string]bool{}
+	for i, f := range c.Foundations {
+		if f.Name == "" {
+			return fmt.Errorf("Foundation %d in the config has no name", i)
+		}
+		if seen[f.Name] {
+			return fmt.Errorf("Foundation `%s` is listed more than once in the config", f.Name)
+		}
+		seen[f.Name] = true
+
+		err = f.BOSH.validateDirectors()
+		if err != nil {
+			return fmt.Errorf("Foundation `%s`: %s", f.Name, err)
+		}
+	}
+	return nil
+}
+
+func (b BOSHConfig) validateDirectors() error {
+	seen := map[string]bool{}
+	segments := map[string]string{}
+	for _, d := range b.Directors {
+		if seen[d.Name] {
+			return fmt.Errorf("BOSH director `%s` is listed more than once in the config", d.Name)
+		}
+		seen[d.Name] = true
+
+		for segment := range d.IsolationSegments {
+			if other, found := segments[segment]; found {
+				return fmt.Errorf("Isolation segment `%s` is mapped on both BOSH director `%s` and `%s`", segment, other, d.Name)
+			}
+			segments[segment] = d.Name
+		}
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package config
+
+import (
+	"testing"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+func TestBOSHConfigShapes(t *testing.T) {
+	for _, test := range []struct {
+		name      string
+		yaml      string
+		directors []string
+		skip      bool
+		bad       bool
+	}{
+		{
+			name:      "single director",
+			yaml:      "api_address: https://bosh:25555\nskip_bosh: true\n",
+			directors: []string{"https://bosh:25555"},
+			skip:      true,
+		},
+		{
+			name:      "list of directors",
+			yaml:      "- name: main\n  api_address: https://a\n- api_address: https://b\n",
+			directors: []string{"main", "https://b"},
+		},
+		{
+			name:      "directors with skip_bosh",
+			yaml:      "skip_bosh: true\ndirectors:\n- name: main\n  api_address: https://a\n",
+			directors: []string{"main"},
+			skip:      true,
+		},
+		{
+			name: "both shapes at once",
+			yaml: "api_address: https://a\ndirectors:\n- name: main\n",
+			bad:  true,
+		},
+	} {
+		var conf BOSHConfig
+		err := yaml.Unmarshal([]byte(test.yaml), &conf)
+		if test.bad {
+			if err == nil {
+				t.Errorf("%s: expected an error", test.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", test.name, err)
+			continue
+		}
+
+		if conf.SkipBOSH != test.skip {
+			t.Errorf("%s: expected skip_bosh to be %t", test.name, test.skip)
+		}
+		if len(conf.Directors) != len(test.directors) {
+			t.Errorf("%s: expected %d directors, got %d", test.name, len(test.directors), len(conf.Directors))
+			continue
+		}
+		for i, name := range test.directors {
+			if conf.Directors[i].Name != name {
+				t.Errorf("%s: expected director %d to be named %s, got %s", test.name, i, name, conf.Directors[i].Name)
+			}
+		}
+	}
+}
@@ -0,0 +1,231 @@
+package seeker
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/starkandwayne/goutils/log"
+)
+
+//ServiceDependency is a service instance bound to an app, along with the hosts
+// that the credentials of the binding point the app at
+type ServiceDependency struct {
+	ServiceName string
+	ServiceGUID string
+	//Label is the name of the service offering, as given in VCAP_SERVICES. It is
+	// empty if the app hasn't been restarted since the service was bound.
+	Label string
+	//Hosts are the hostnames and IP addresses found in the credentials, in the
+	// form they were given there
+	Hosts []string
+}
+
+//vcapService is an entry in the VCAP_SERVICES of an app
+type vcapService struct {
+	Label       string
+	Credentials interface{}
+}
+
+//AppServiceDependencies looks up the service bindings of the app with the given
+// GUID and pulls the hosts out of their credentials. The credentials of each
+// binding are read both from the binding itself and from the VCAP_SERVICES that
+// the app was last started with.
+func (s *Seeker) AppServiceDependencies(guid string) (ret []ServiceDependency, err error) {
+	query := url.Values{}
+	query.Set("q", "app_guid:"+guid)
+	log.Debugf("Getting service bindings of app with GUID %s from CF API", guid)
+	bindings, err := s.CF.ListServiceBindingsByQuery(query)
+	if err != nil {
+		err = fmt.Errorf("While listing service bindings of app with GUID `%s`: %s", guid, err.Error())
+		return
+	}
+
+	log.Debugf("Getting environment of app with GUID %s from CF API", guid)
+	env, err := s.CF.GetAppEnv(guid)
+	if err != nil {
+		err = fmt.Errorf("While getting environment of app with GUID `%s`: %s", guid, err.Error())
+		return
+	}
+	vcap := vcapServices(env.SystemEnv)
+
+	for _, binding := range bindings {
+		dep := ServiceDependency{ServiceGUID: binding.ServiceInstanceGuid}
+		dep.ServiceName, err = s.serviceInstanceName(binding.ServiceInstanceGuid)
+		if err != nil {
+			return nil, err
+		}
+
+		hosts := credentialHosts(binding.Credentials)
+		if entry, found := vcap[dep.ServiceName]; found {
+			dep.Label = entry.Label
+			hosts = append(hosts, credentialHosts(entry.Credentials)...)
+		}
+		dep.Hosts = uniqueSorted(hosts)
+		ret = append(ret, dep)
+	}
+
+	sort.Sort(serviceDependenciesByName(ret))
+	return
+}
+
+//serviceInstanceName returns the name of the managed or user-provided service
+// instance with the given GUID
+func (s *Seeker) serviceInstanceName(guid string) (string, error) {
+	instance, err := s.findServiceInstance(guid)
+	if err != nil {
+		return "", err
+	}
+	return instance.Name, nil
+}
+
+//vcapServices reads the VCAP_SERVICES out of the system environment of an app,
+// keyed by service instance name
+func vcapServices(systemEnv map[string]interface{}) map[string]vcapService {
+	ret := map[string]vcapService{}
+	byLabel, _ := systemEnv["VCAP_SERVICES"].(map[string]interface{})
+	for label, entries := range byLabel {
+		list, _ := entries.([]interface{})
+		for _, entry := range list {
+			fields, _ := entry.(map[string]interface{})
+			name, _ := fields["name"].(string)
+			if name == "" {
+				continue
+			}
+			ret[name] = vcapService{Label: label, Credentials: fields["credentials"]}
+		}
+	}
+	return ret
+}
+
+//credentialHosts finds the hostnames and IP addresses in the given binding
+// credentials. IP addresses are picked up wherever they are. Hostnames are only
+// picked up from values whose keys suggest that they hold a host or a URI, so
+// that passwords and the like aren't mistaken for them.
+func credentialHosts(creds interface{}) (ret []string) {
+	var walk func(key string, value interface{})
+	walk = func(key string, value interface{}) {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			for k, sub := range v {
+				walk(k, sub)
+			}
+		case []interface{}:
+			for _, sub := range v {
+				walk(key, sub)
+			}
+		case string:
+			ret = append(ret, hostsIn(key, v)...)
+		}
+	}
+	walk("", creds)
+	return
+}
+
+//hostsIn returns the hosts in a credentials value with the given key. Values may
+// be bare hosts, host:port pairs, comma-separated lists of those, or URIs, which
+// may themselves list more than one host, as MongoDB URIs do.
+func hostsIn(key, value string) (ret []string) {
+	if net.ParseIP(value) != nil {
+		return []string{value}
+	}
+	if !isHostKey(key) {
+		return nil
+	}
+
+	rest := value
+	if i := strings.Index(rest, "://"); i >= 0 {
+		rest = rest[i+3:]
+	}
+	if i := strings.IndexAny(rest, "/?"); i >= 0 {
+		rest = rest[:i]
+	}
+	if i := strings.LastIndex(rest, "@"); i >= 0 {
+		rest = rest[i+1:]
+	}
+
+	for _, part := range strings.Split(rest, ",") {
+		host := strings.TrimSpace(part)
+		if h, _, err := net.SplitHostPort(host); err == nil {
+			host = h
+		}
+		host = strings.Trim(host, "[]")
+		if looksLikeHost(host) {
+			ret = append(ret, host)
+		}
+	}
+	return
+}
+
+func isHostKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, hint := range []string{"host", "uri", "url", "addr", "server", "endpoint", "node"} {
+		if strings.Contains(key, hint) {
+			return true
+		}
+	}
+	return key == "ip" || key == "ips" || strings.HasSuffix(key, "_ip") || strings.HasSuffix(key, "_ips")
+}
+
+//looksLikeHost returns true if the given string is an IP address, or is made of
+// the characters of a hostname and has more than one label
+func looksLikeHost(host string) bool {
+	if net.ParseIP(host) != nil {
+		return true
+	}
+	if !strings.Contains(host, ".") {
+		return false
+	}
+	for _, c := range host {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '.' || c == '-' || c == '_') {
+			return false
+		}
+	}
+	return true
+}
+
+func uniqueSorted(in []string) (ret []string) {
+	seen := map[string]bool{}
+	for _, s := range in {
+		if !seen[s] {
+			seen[s] = true
+			ret = append(ret, s)
+		}
+	}
+	sort.Strings(ret)
+	return
+}
+
+//ResolveHost returns the IP addresses of the given host. If the host is already
+// an IP address, it is returned in canonical form.
+func ResolveHost(host string) (ips []string, err error) {
+	if net.ParseIP(host) != nil {
+		ip, err := canonizeIP(host)
+		return []string{ip}, err
+	}
+
+	log.Debugf("Resolving host (%s)", host)
+	addrs, err := net.LookupHost(host)
+	if err != nil {
+		return nil, err
+	}
+	for _, addr := range addrs {
+		var ip string
+		ip, err = canonizeIP(addr)
+		if err != nil {
+			return nil, err
+		}
+		ips = append(ips, ip)
+	}
+	return uniqueSorted(ips), nil
+}
+
+type serviceDependenciesByName []ServiceDependency
+
+func (d serviceDependenciesByName) Len() int      { return len(d) }
+func (d serviceDependenciesByName) Swap(i, j int) { d[i], d[j] = d[j], d[i] }
+func (d serviceDependenciesByName) Less(i, j int) bool {
+	return d[i].ServiceName < d[j].ServiceName
+}
@@ -0,0 +1,241 @@
+package seeker
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/starkandwayne/goutils/log"
+)
+
+//defaultCFCacheTTL is how long names and GUIDs are cached if SetCFCacheTTL isn't
+// called
+const defaultCFCacheTTL = 5 * time.Minute
+
+const (
+	cfCacheOrg   = "org"
+	cfCacheSpace = "space"
+	cfCacheApp   = "app"
+)
+
+//CFCache contains the names and GUIDs of the orgs, spaces, and apps that have
+// been looked up from the CF API, so that they aren't looked up again until
+// the TTL passes
+type CFCache struct {
+	entries map[string]*cfCacheEntry //keyed by both the name and the GUID of the entry
+	ttl     time.Duration
+	hits    int
+	misses  int
+	//sweptAt is when stale entries were last dropped
+	sweptAt time.Time
+	lock    sync.Mutex
+}
+
+type cfCacheEntry struct {
+	GUID string
+	Name string
+	//ParentGUID is the GUID of the org of a space, or of the space of an app
+	ParentGUID string
+	cachedAt   time.Time
+}
+
+//CFCacheStats tells how many lookups the CF name and GUID cache has answered
+// since it was made or last invalidated, and how many it had to pass on to the
+// CF API
+type CFCacheStats struct {
+	Hits   int `json:"hits" yaml:"hits"`
+	Misses int `json:"misses" yaml:"misses"`
+	//Entries counts the orgs, spaces, and apps cached, including stale ones which
+	// haven't been dropped yet
+	Entries int `json:"entries" yaml:"entries"`
+}
+
+func newCFCache() *CFCache {
+	return &CFCache{
+		entries: map[string]*cfCacheEntry{},
+		ttl:     defaultCFCacheTTL,
+		sweptAt: time.Now(),
+	}
+}
+
+//cfNameKey is the cache key of the org, space, or app with the given name
+// within the org or space with the given GUID. Orgs have no scope.
+func cfNameKey(kind, scopeGUID, name string) string {
+	return kind + ":" + scopeGUID + "/" + name
+}
+
+func cfGUIDKey(kind, guid string) string {
+	return kind + "#" + guid
+}
+
+//SetCFCacheTTL sets how long org, space, and app names and GUIDs are cached.
+// A TTL of zero turns the cache off.
+func (s *Seeker) SetCFCacheTTL(ttl time.Duration) {
+	log.Debugf("Setting CF name cache TTL (%s)", ttl)
+	s.cfcache.lock.Lock()
+	defer s.cfcache.lock.Unlock()
+	s.cfcache.ttl = ttl
+}
+
+//InvalidateCFCache wipes the CF name and GUID cache and its stats
+func (s *Seeker) InvalidateCFCache() {
+	log.Debugf("Invalidating CF name cache for Seeker (%p)", s)
+	s.cfcache.lock.Lock()
+	defer s.cfcache.lock.Unlock()
+	s.cfcache.entries = map[string]*cfCacheEntry{}
+	s.cfcache.hits, s.cfcache.misses = 0, 0
+}
+
+//CFCacheStats returns how well the CF name and GUID cache is doing
+func (s *Seeker) CFCacheStats() CFCacheStats {
+	c := s.cfcache
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	unique := map[*cfCacheEntry]bool{}
+	for _, entry := range c.entries {
+		unique[entry] = true
+	}
+	return CFCacheStats{Hits: c.hits, Misses: c.misses, Entries: len(unique)}
+}
+
+//get returns the entry with the given key if it is cached and fresh, counting
+// the lookup as a hit or a miss
+func (c *CFCache) get(key string) (ret cfCacheEntry, found bool) {
+	ret, _, found = c.getAny(key)
+	return
+}
+
+//getAny returns the first of the entries with the given keys that is cached and
+// fresh, along with its key. It counts as one hit or miss.
+func (c *CFCache) getAny(keys ...string) (ret cfCacheEntry, key string, found bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for _, key = range keys {
+		entry := c.entries[key]
+		if entry != nil && (c.ttl < 0 || time.Since(entry.cachedAt) < c.ttl) {
+			log.Debugf("CF name cache HIT for %s", key)
+			c.hits++
+			return *entry, key, true
+		}
+	}
+	log.Debugf("CF name cache MISS for %s", strings.Join(keys, ", "))
+	c.misses++
+	return ret, "", false
+}
+
+//put caches the given entry under each of the given keys. Once a TTL has passed
+// since stale entries were last dropped, they are dropped again, so that
+// entries which are never looked up again don't pile up.
+func (c *CFCache) put(entry cfCacheEntry, keys ...string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.ttl == 0 {
+		return
+	}
+	entry.cachedAt = time.Now()
+	if c.ttl > 0 && entry.cachedAt.Sub(c.sweptAt) >= c.ttl {
+		c.sweep(entry.cachedAt)
+	}
+	for _, key := range keys {
+		c.entries[key] = &entry
+	}
+}
+
+//sweep drops every entry which was cached at least a TTL before the given time
+// SYNC: Expected that you have the lock when you call this function.
+func (c *CFCache) sweep(now time.Time) {
+	var dropped int
+	for key, entry := range c.entries {
+		if now.Sub(entry.cachedAt) >= c.ttl {
+			delete(c.entries, key)
+			dropped++
+		}
+	}
+	c.sweptAt = now
+	log.Debugf("Dropped %d stale keys from the CF name cache", dropped)
+}
+
+//forgetGUID drops every entry of the given kind with the given GUID, under
+// whichever keys it is cached
+func (c *CFCache) forgetGUID(kind, guid string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for key, entry := range c.entries {
+		if entry.GUID == guid && (strings.HasPrefix(key, kind+":") || strings.HasPrefix(key, kind+"#")) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+//cacheOrg caches the org with the given GUID and name. If it was looked up by
+// a name other than its own, that name is given as alias.
+func (s *Seeker) cacheOrg(guid, name, alias string) {
+	s.cfcache.put(cfCacheEntry{GUID: guid, Name: name},
+		cfGUIDKey(cfCacheOrg, guid), cfNameKey(cfCacheOrg, "", name), cfNameKey(cfCacheOrg, "", alias))
+}
+
+func (s *Seeker) cacheSpace(guid, name, orgGUID, alias string) {
+	s.cfcache.put(cfCacheEntry{GUID: guid, Name: name, ParentGUID: orgGUID},
+		cfGUIDKey(cfCacheSpace, guid), cfNameKey(cfCacheSpace, orgGUID, name), cfNameKey(cfCacheSpace, orgGUID, alias))
+}
+
+func (s *Seeker) cacheApp(guid, name, spaceGUID, alias string) {
+	s.cfcache.put(cfCacheEntry{GUID: guid, Name: name, ParentGUID: spaceGUID},
+		cfGUIDKey(cfCacheApp, guid), cfNameKey(cfCacheApp, spaceGUID, name), cfNameKey(cfCacheApp, spaceGUID, alias))
+}
+
+//OrgByGUID looks up the name of the org with the given GUID. Errors from the CF
+// API are returned as they are.
+func (s *Seeker) OrgByGUID(guid string) (name string, err error) {
+	if entry, found := s.cfcache.get(cfGUIDKey(cfCacheOrg, guid)); found {
+		return entry.Name, nil
+	}
+
+	log.Debugf("Getting org with GUID %s from CF API", guid)
+	org, err := s.CF.GetOrgByGuid(guid)
+	if err != nil {
+		return
+	}
+	s.cacheOrg(org.Guid, org.Name, org.Name)
+	return org.Name, nil
+}
+
+//SpaceByGUID looks up the name of the space with the given GUID, and the GUID
+// of the org it is in. Errors from the CF API are returned as they are.
+func (s *Seeker) SpaceByGUID(guid string) (name, orgGUID string, err error) {
+	if entry, found := s.cfcache.get(cfGUIDKey(cfCacheSpace, guid)); found {
+		return entry.Name, entry.ParentGUID, nil
+	}
+
+	log.Debugf("Getting space with GUID %s from CF API", guid)
+	space, err := s.CF.GetSpaceByGuid(guid)
+	if err != nil {
+		return
+	}
+	s.cacheSpace(space.Guid, space.Name, space.OrganizationGuid, space.Name)
+	return space.Name, space.OrganizationGuid, nil
+}
+
+//AppByGUID looks up the name of the app with the given GUID, and the GUID of
+// the space it is in. The space and org of the app are cached along with it.
+// Errors from the CF API are returned as they are.
+func (s *Seeker) AppByGUID(guid string) (name, spaceGUID string, err error) {
+	if entry, found := s.cfcache.get(cfGUIDKey(cfCacheApp, guid)); found {
+		return entry.Name, entry.ParentGUID, nil
+	}
+
+	log.Debugf("Getting app with GUID %s from CF API", guid)
+	app, err := s.CF.GetAppByGuid(guid)
+	if err != nil {
+		return
+	}
+	space := app.SpaceData.Entity
+	org := space.OrgData.Entity
+	if space.Guid != "" && org.Guid != "" {
+		s.cacheSpace(space.Guid, space.Name, org.Guid, space.Name)
+		s.cacheOrg(org.Guid, org.Name, org.Name)
+	}
+	s.cacheApp(app.Guid, app.Name, app.SpaceGuid, app.Name)
+	return app.Name, app.SpaceGuid, nil
+}
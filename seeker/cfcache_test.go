@@ -0,0 +1,79 @@
+package seeker
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCFCacheDropsStaleEntries(t *testing.T) {
+	c := newCFCache()
+	c.ttl = time.Minute
+
+	c.put(cfCacheEntry{GUID: "old-guid", Name: "old"}, cfGUIDKey(cfCacheOrg, "old-guid"), cfNameKey(cfCacheOrg, "", "old"), cfNameKey(cfCacheOrg, "", "OLD"))
+	for _, entry := range c.entries {
+		entry.cachedAt = time.Now().Add(-2 * time.Minute)
+	}
+	c.sweptAt = time.Now().Add(-2 * time.Minute)
+
+	c.put(cfCacheEntry{GUID: "new-guid", Name: "new"}, cfGUIDKey(cfCacheOrg, "new-guid"), cfNameKey(cfCacheOrg, "", "new"))
+	if len(c.entries) != 2 {
+		t.Errorf("Expected the stale entry and its aliases to be dropped, leaving 2 keys, got %d", len(c.entries))
+	}
+	if _, found := c.get(cfGUIDKey(cfCacheOrg, "new-guid")); !found {
+		t.Errorf("Expected the fresh entry to be cached")
+	}
+
+	if newCFCache().ttl < 0 {
+		t.Errorf("Expected the CF name cache to expire entries by default")
+	}
+}
+
+func TestFindInstancesByNameAfterRepush(t *testing.T) {
+	appGUID := "old-app-guid"
+	var lock sync.Mutex
+
+	s := newTestSeeker(t)
+	srv := useFakeCF(s, func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		current := appGUID
+		lock.Unlock()
+
+		list := func(guid, name string) {
+			fmt.Fprintf(w, `{"total_results": 1, "total_pages": 1, "resources": [{"metadata": {"guid": %q}, "entity": {"name": %q}}]}`, guid, name)
+		}
+		switch r.URL.Path {
+		case "/v2/organizations":
+			list("org-guid", "sandbox")
+		case "/v2/spaces":
+			list("space-guid", "dev")
+		case "/v2/apps":
+			list(current, "web")
+		case "/v2/apps/" + current + "/stats":
+			w.Write([]byte(`{"0": {"state": "RUNNING", "stats": {"name": "web", "host": "10.0.0.1", "port": 61000}}}`))
+		default:
+			w.WriteHeader(404)
+			w.Write([]byte(`{"code": 100004, "description": "The app could not be found", "error_code": "CF-AppNotFound"}`))
+		}
+	})
+	defer srv.Close()
+
+	for _, want := range []string{"old-app-guid", "new-app-guid"} {
+		lock.Lock()
+		appGUID = want
+		lock.Unlock()
+
+		meta, inst, err := s.FindInstancesByName("sandbox", "dev", "web")
+		if err != nil {
+			t.Fatalf("Could not find instances of app with GUID %s: %s", want, err)
+		}
+		if meta.GUID != want || len(inst) != 1 {
+			t.Errorf("Expected the instance of app with GUID %s, got %+v, %+v", want, meta, inst)
+		}
+	}
+	if _, found := s.cfcache.get(cfGUIDKey(cfCacheApp, "old-app-guid")); found {
+		t.Errorf("Expected the GUID of the deleted app to be forgotten")
+	}
+}
@@ -0,0 +1,211 @@
+package seeker
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/starkandwayne/goutils/log"
+)
+
+//defaultListingTTL is how long listings are kept if SetListingTTL isn't called
+const defaultListingTTL = time.Minute
+
+//listingCache holds the orgs, the spaces in each org, and the apps in each
+// space as the CF API last listed them, so that names can be completed without
+// listing them again for every keystroke
+type listingCache struct {
+	listings map[string]*listing //keyed by what was listed, such as spaces:<org guid>
+	//allApps is every app in every org and space, as searched by SearchApps
+	allApps *appListing
+	ttl     time.Duration
+	lock    sync.Mutex
+}
+
+type appListing struct {
+	apps     []ScopedApp
+	cachedAt time.Time
+}
+
+type listing struct {
+	resources []namedResource
+	cachedAt  time.Time
+}
+
+func newListingCache() *listingCache {
+	return &listingCache{
+		listings: map[string]*listing{},
+		ttl:      defaultListingTTL,
+	}
+}
+
+//SetListingTTL sets how long the org, space, and app listings used to complete
+// names are kept before the CF API is asked for them again
+func (s *Seeker) SetListingTTL(ttl time.Duration) {
+	log.Debugf("Setting CF listing cache TTL (%s)", ttl)
+	s.listings.lock.Lock()
+	defer s.listings.lock.Unlock()
+	s.listings.ttl = ttl
+}
+
+//CompleteOrgNames returns the names of the orgs that begin with the given
+// prefix, ignoring case, in alphabetical order
+func (s *Seeker) CompleteOrgNames(prefix string) (ret []string, err error) {
+	orgs, err := s.listOrgs()
+	if err != nil {
+		return
+	}
+	return withPrefix(orgs, prefix), nil
+}
+
+//CompleteSpaceNames returns the names of the spaces in the given org that begin
+// with the given prefix, ignoring case, in alphabetical order. If there is no
+// such org, no names are returned.
+func (s *Seeker) CompleteSpaceNames(orgname, prefix string) (ret []string, err error) {
+	spaces, _, err := s.listSpaces(orgname)
+	if err != nil {
+		return
+	}
+	return withPrefix(spaces, prefix), nil
+}
+
+//CompleteAppNames returns the names of the apps in the given org and space that
+// begin with the given prefix, ignoring case, in alphabetical order. If there is
+// no such org or space, no names are returned.
+func (s *Seeker) CompleteAppNames(orgname, spacename, prefix string) (ret []string, err error) {
+	spaces, found, err := s.listSpaces(orgname)
+	if err != nil || !found {
+		return
+	}
+	space, found := namedIn(spaces, spacename)
+	if !found {
+		return
+	}
+
+	apps, err := s.cachedListing("apps:"+space.GUID, func() (ret []namedResource, err error) {
+		log.Debugf("Listing apps in space with GUID %s from CF API", space.GUID)
+		apps, err := s.CF.ListAppsByQuery(url.Values{"q": []string{"space_guid:" + space.GUID}})
+		for _, app := range apps {
+			ret = append(ret, namedResource{Name: app.Name, GUID: app.Guid})
+		}
+		return
+	})
+	if err != nil {
+		return
+	}
+	return withPrefix(apps, prefix), nil
+}
+
+func (s *Seeker) listOrgs() ([]namedResource, error) {
+	return s.cachedListing("orgs", func() (ret []namedResource, err error) {
+		log.Debugf("Listing orgs from CF API")
+		orgs, err := s.CF.ListOrgs()
+		for _, org := range orgs {
+			ret = append(ret, namedResource{Name: org.Name, GUID: org.Guid})
+		}
+		return
+	})
+}
+
+//listSpaces lists the spaces in the org with the given name. found is false if
+// there is no such org.
+func (s *Seeker) listSpaces(orgname string) (spaces []namedResource, found bool, err error) {
+	orgs, err := s.listOrgs()
+	if err != nil {
+		return
+	}
+	org, found := namedIn(orgs, orgname)
+	if !found {
+		return
+	}
+
+	spaces, err = s.cachedListing("spaces:"+org.GUID, func() (ret []namedResource, err error) {
+		log.Debugf("Listing spaces in org with GUID %s from CF API", org.GUID)
+		spaces, err := s.CF.ListSpacesByQuery(url.Values{"q": []string{"organization_guid:" + org.GUID}})
+		for _, space := range spaces {
+			ret = append(ret, namedResource{Name: space.Name, GUID: space.Guid})
+		}
+		return
+	})
+	return
+}
+
+//cachedListing returns the listing with the given key, calling list to make it
+// if it isn't cached or has outlived the TTL. Failed listings aren't cached.
+func (s *Seeker) cachedListing(key string, list func() ([]namedResource, error)) ([]namedResource, error) {
+	c := s.listings
+	c.lock.Lock()
+	entry := c.listings[key]
+	ttl := c.ttl
+	c.lock.Unlock()
+	if entry != nil && (ttl < 0 || time.Since(entry.cachedAt) < ttl) {
+		log.Debugf("Listing cache HIT for %s", key)
+		return entry.resources, nil
+	}
+
+	log.Debugf("Listing cache MISS for %s", key)
+	resources, err := list()
+	if err != nil {
+		return nil, err
+	}
+	c.lock.Lock()
+	c.listings[key] = &listing{resources: resources, cachedAt: time.Now()}
+	c.lock.Unlock()
+	return resources, nil
+}
+
+//listAllApps lists every app in every org and space, with the names of their
+// org and space, reusing the last listing if it hasn't outlived the TTL
+func (s *Seeker) listAllApps() ([]ScopedApp, error) {
+	c := s.listings
+	c.lock.Lock()
+	entry := c.allApps
+	ttl := c.ttl
+	c.lock.Unlock()
+	if entry != nil && (ttl < 0 || time.Since(entry.cachedAt) < ttl) {
+		log.Debugf("Listing cache HIT for every app")
+		return entry.apps, nil
+	}
+
+	log.Debugf("Listing cache MISS for every app. Listing them from CF API")
+	query := url.Values{}
+	query.Set("inline-relations-depth", "2")
+	cfApps, err := s.CF.ListAppsByQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	apps := make([]ScopedApp, 0, len(cfApps))
+	for _, app := range cfApps {
+		apps = append(apps, scopedAppFromCF(app))
+	}
+	c.lock.Lock()
+	c.allApps = &appListing{apps: apps, cachedAt: time.Now()}
+	c.lock.Unlock()
+	return apps, nil
+}
+
+//namedIn returns the resource with exactly the given name, or else the only one
+// whose name differs from it by case
+func namedIn(resources []namedResource, name string) (ret namedResource, found bool) {
+	for _, resource := range resources {
+		if resource.Name == name {
+			return resource, true
+		}
+	}
+	ret, err := matchName("", name, resources)
+	return ret, err == nil
+}
+
+func withPrefix(resources []namedResource, prefix string) (ret []string) {
+	prefix = strings.ToLower(prefix)
+	ret = []string{}
+	for _, resource := range resources {
+		if strings.HasPrefix(strings.ToLower(resource.Name), prefix) {
+			ret = append(ret, resource.Name)
+		}
+	}
+	sort.Strings(ret)
+	return
+}
@@ -0,0 +1,154 @@
+package seeker
+
+import (
+	"sync"
+	"time"
+
+	"github.com/starkandwayne/goutils/log"
+)
+
+//defaultIndexTTL is how long an index built on demand is reused if SetIndexTTL
+// isn't called
+const defaultIndexTTL = time.Minute
+
+//crawler holds the state of the background crawler that keeps an
+// InstanceIndex of the whole foundation up to date, and of the index built on
+// demand when the crawler has none
+type crawler struct {
+	index     *InstanceIndex
+	staleness time.Duration
+	workers   int
+	lock      sync.RWMutex
+	//built is the index last built on demand, which is reused for builtTTL
+	built    *InstanceIndex
+	builtTTL time.Duration
+	//buildLock is held while an index is built on demand, so that only one is
+	// built at once
+	buildLock sync.Mutex
+}
+
+func newCrawler(workers int) *crawler {
+	if workers <= 0 {
+		workers = defaultWorkers
+	}
+	return &crawler{workers: workers, builtTTL: defaultIndexTTL}
+}
+
+//StartCrawler launches a goroutine that refreshes the BOSH VM cache and
+// rebuilds the instance index every interval. Once the index is older than
+// staleness, it is no longer returned by CurrentIndex. workers is how many app
+// stats requests are made at once while building an index.
+func (s *Seeker) StartCrawler(interval, staleness time.Duration, workers int) {
+	log.Debugf("Starting crawler. Interval: %s, Staleness: %s, Workers: %d", interval, staleness, workers)
+	s.crawler.lock.Lock()
+	s.crawler.staleness = staleness
+	if workers > 0 {
+		s.crawler.workers = workers
+	}
+	s.crawler.lock.Unlock()
+
+	go func() {
+		for {
+			s.crawl()
+			time.Sleep(interval)
+		}
+	}()
+}
+
+func (s *Seeker) crawl() {
+	log.Infof("Beginning crawl of foundation")
+	start := time.Now()
+
+	if s.BOSHConfigured() {
+		err := s.cacheAll()
+		if err != nil {
+			log.Errorf("Crawler could not refresh VMs: %s", err.Error())
+		}
+	}
+
+	index, err := s.BuildInstanceIndex()
+	if err != nil {
+		log.Errorf("Crawler could not build instance index: %s", err.Error())
+		return
+	}
+
+	s.crawler.lock.Lock()
+	s.crawler.index = index
+	s.crawler.lock.Unlock()
+	log.Infof("Crawl finished in %s. Indexed %d instances", time.Since(start), len(index.Instances))
+}
+
+//CurrentIndex returns the instance index most recently built by the crawler.
+// If the crawler isn't running, hasn't finished a crawl yet, or its index has
+// gone stale, nil is returned.
+func (s *Seeker) CurrentIndex() *InstanceIndex {
+	s.crawler.lock.RLock()
+	defer s.crawler.lock.RUnlock()
+	c := s.crawler
+	if c.index == nil {
+		return nil
+	}
+
+	if age := time.Since(c.index.BuiltAt); age >= c.staleness {
+		log.Debugf("Instance index deemed stale. Age: %s, Staleness: %s", age, c.staleness)
+		return nil
+	}
+	return c.index
+}
+
+//SetIndexTTL sets how long an instance index built on demand, because the
+// crawler has no fresh index, is reused before another is built
+func (s *Seeker) SetIndexTTL(ttl time.Duration) {
+	log.Debugf("Setting instance index TTL (%s)", ttl)
+	s.crawler.lock.Lock()
+	defer s.crawler.lock.Unlock()
+	s.crawler.builtTTL = ttl
+}
+
+//RecentIndex returns the index kept by the crawler if there is a fresh one.
+// Otherwise, the index last built on demand is returned if it is younger than
+// the index TTL, and if it isn't, a new index is built and kept.
+func (s *Seeker) RecentIndex() (index *InstanceIndex, err error) {
+	if index = s.CurrentIndex(); index != nil {
+		log.Debugf("Using instance index from crawler")
+		return
+	}
+
+	c := s.crawler
+	c.buildLock.Lock()
+	defer c.buildLock.Unlock()
+	if index = s.builtIndex(); index != nil {
+		log.Debugf("Using instance index built at %s", index.BuiltAt)
+		return
+	}
+
+	log.Debugf("Building foundation instance index")
+	index, err = s.BuildInstanceIndex()
+	if err != nil {
+		return
+	}
+	c.lock.Lock()
+	c.built = index
+	c.lock.Unlock()
+	return
+}
+
+//builtIndex returns the index last built on demand, or nil if there isn't one
+// or it has outlived the index TTL
+func (s *Seeker) builtIndex() *InstanceIndex {
+	s.crawler.lock.RLock()
+	defer s.crawler.lock.RUnlock()
+	c := s.crawler
+	if c.built == nil || (c.builtTTL >= 0 && time.Since(c.built.BuiltAt) >= c.builtTTL) {
+		return nil
+	}
+	return c.built
+}
+
+//Workers returns how many app stats requests should be made at once when
+// looking up many apps
+func (s *Seeker) Workers() int {
+	s.crawler.lock.RLock()
+	defer s.crawler.lock.RUnlock()
+	return s.crawler.workers
+}
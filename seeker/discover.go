@@ -0,0 +1,240 @@
+package seeker
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/cloudfoundry-community/cfseeker/config"
+	"github.com/starkandwayne/goutils/log"
+)
+
+const defaultDiscoveryInterval = 10 * time.Minute
+
+//discovery holds the deployments found on each BOSH director that has
+// discovery turned on
+type discovery struct {
+	filters map[string]*deploymentFilter      //keyed by director name
+	found   map[string]*discoveredDeployments //keyed by director name
+	lock    sync.Mutex
+}
+
+//deploymentFilter decides which of the deployments on a director are searched
+type deploymentFilter struct {
+	include  []*regexp.Regexp
+	exclude  []*regexp.Regexp
+	releases map[string]bool
+	interval time.Duration
+}
+
+type discoveredDeployments struct {
+	names []string
+	at    time.Time
+}
+
+func newDiscovery(directors []config.BOSHDirectorConfig) (ret *discovery, err error) {
+	ret = &discovery{
+		filters: map[string]*deploymentFilter{},
+		found:   map[string]*discoveredDeployments{},
+	}
+
+	for _, director := range directors {
+		if director.Discover == nil {
+			continue
+		}
+
+		conf := director.Discover
+		filter := &deploymentFilter{
+			releases: map[string]bool{},
+			interval: time.Duration(conf.Interval) * time.Second,
+		}
+		if filter.interval <= 0 {
+			filter.interval = defaultDiscoveryInterval
+		}
+
+		filter.include, err = compileAll(conf.Include)
+		if err != nil {
+			return nil, fmt.Errorf("Bad include regex for director `%s`: %s", director.Name, err.Error())
+		}
+		filter.exclude, err = compileAll(conf.Exclude)
+		if err != nil {
+			return nil, fmt.Errorf("Bad exclude regex for director `%s`: %s", director.Name, err.Error())
+		}
+		for _, release := range conf.Releases {
+			filter.releases[release] = true
+		}
+
+		ret.filters[director.Name] = filter
+	}
+	return
+}
+
+func compileAll(exprs []string) (ret []*regexp.Regexp, err error) {
+	for _, expr := range exprs {
+		var re *regexp.Regexp
+		re, err = regexp.Compile(expr)
+		if err != nil {
+			return
+		}
+		ret = append(ret, re)
+	}
+	return
+}
+
+//matches returns true if the deployment with the given name and releases
+// passes the filter
+func (f *deploymentFilter) matches(name string, releases []string) bool {
+	if len(f.include) > 0 && !anyMatch(f.include, name) {
+		return false
+	}
+	if anyMatch(f.exclude, name) {
+		return false
+	}
+	if len(f.releases) == 0 {
+		return true
+	}
+	for _, release := range releases {
+		if f.releases[release] {
+			return true
+		}
+	}
+	return false
+}
+
+func anyMatch(res []*regexp.Regexp, s string) bool {
+	for _, re := range res {
+		if re.MatchString(s) {
+			return true
+		}
+	}
+	return false
+}
+
+//StartDiscovery launches a goroutine for each BOSH director with discovery
+// turned on, which discovers the deployments on it every discovery interval, so
+// that lookups don't have to wait for discovery.
+func (s *Seeker) StartDiscovery() {
+	if !s.BOSHConfigured() {
+		return
+	}
+
+	for _, director := range s.directors() {
+		filter := s.discovery.filters[director.Name]
+		if filter == nil {
+			continue
+		}
+
+		log.Debugf("Starting discovery on BOSH director (%s). Interval: %s", director.Name, filter.interval)
+		go func(director config.BOSHDirectorConfig, interval time.Duration) {
+			for {
+				s.discovery.lock.Lock()
+				_, err := s.discover(director)
+				s.discovery.lock.Unlock()
+				if err != nil {
+					log.Errorf(err.Error())
+				}
+				time.Sleep(interval)
+			}
+		}(director, filter.interval)
+	}
+}
+
+//discoveredDeployments returns the deployments on the given director which pass
+// its filter, asking the director again if the last answer is older than the
+// discovery interval. If the director can't be reached, the last answer is used
+// if there is one. No deployments are returned for directors without discovery.
+func (s *Seeker) discoveredDeployments(director config.BOSHDirectorConfig) (names []string, err error) {
+	d := s.discovery
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	filter := d.filters[director.Name]
+	if filter == nil {
+		return
+	}
+
+	found := d.found[director.Name]
+	if found != nil && time.Since(found.at) < filter.interval {
+		return found.names, nil
+	}
+	return s.discover(director)
+}
+
+//discover asks the given director for its deployments, and records the ones
+// which pass its filter. Deployments that were discovered before but no longer
+// are, and which aren't configured, are dropped from the VM cache. If the
+// director can't be reached, the last answer is used if there is one.
+// SYNC: Expected that you have the discovery lock when you call this function.
+func (s *Seeker) discover(director config.BOSHDirectorConfig) (names []string, err error) {
+	d := s.discovery
+	filter := d.filters[director.Name]
+	found := d.found[director.Name]
+
+	log.Debugf("Discovering deployments on BOSH director (%s)", director.Name)
+	deployments, err := s.bosh[director.Name].GetDeployments()
+	if err != nil {
+		err = fmt.Errorf("Error while discovering deployments on director `%s`: %s", director.Name, err.Error())
+		if found == nil {
+			return
+		}
+		log.Errorf("%s. Using the deployments discovered at %s", err.Error(), found.at)
+		return found.names, nil
+	}
+
+	for _, dep := range deployments {
+		var releases []string
+		for _, release := range dep.Releases {
+			releases = append(releases, release.Name)
+		}
+		if filter.matches(dep.Name, releases) {
+			names = append(names, dep.Name)
+		}
+	}
+	log.Debugf("Discovered %d deployments on BOSH director (%s)", len(names), director.Name)
+	d.found[director.Name] = &discoveredDeployments{names: names, at: time.Now()}
+
+	if found != nil {
+		s.dropUndiscoveredDeployments(director, found.names, names)
+	}
+	return
+}
+
+//dropUndiscoveredDeployments removes the deployments of the given director which
+// were previously discovered from the VM cache if they are neither configured
+// nor discovered anymore. Deployments cached for any other reason, such as
+// being looked up by name, are left alone.
+func (s *Seeker) dropUndiscoveredDeployments(director config.BOSHDirectorConfig, previous, discovered []string) {
+	keep := map[string]bool{}
+	for _, name := range director.Deployments {
+		keep[name] = true
+	}
+	for _, name := range discovered {
+		keep[name] = true
+	}
+
+	s.acquireLock()
+	defer s.releaseLock()
+	for _, name := range previous {
+		dep := boshDeployment{Director: director.Name, Name: name}
+		if !keep[name] && s.vmcache.deployments[dep] != nil {
+			s.invalidateDeployment(dep)
+		}
+	}
+}
+
+//Deployments returns the names of the deployments that are searched on each
+// configured BOSH director, keyed by director name. Deployments are discovered
+// first on any director where that is turned on.
+func (s *Seeker) Deployments() (ret map[string][]string, err error) {
+	deployments, err := s.boshDeployments()
+	if err != nil {
+		return
+	}
+
+	ret = map[string][]string{}
+	for _, dep := range deployments {
+		ret[dep.Director] = append(ret[dep.Director], dep.Name)
+	}
+	return
+}
@@ -0,0 +1,113 @@
+package seeker
+
+import (
+	"testing"
+
+	"github.com/cloudfoundry-community/cfseeker/config"
+	"github.com/cloudfoundry-community/gogobosh"
+)
+
+func TestDeploymentFilter(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		conf     config.BOSHDiscoveryConfig
+		dep      string
+		releases []string
+		matches  bool
+	}{
+		{"no filters", config.BOSHDiscoveryConfig{}, "anything", nil, true},
+		{"included", config.BOSHDiscoveryConfig{Include: []string{"^cf"}}, "cf-iso", nil, true},
+		{"not included", config.BOSHDiscoveryConfig{Include: []string{"^cf"}}, "mysql", nil, false},
+		{"exclude beats include", config.BOSHDiscoveryConfig{Include: []string{"^cf"}, Exclude: []string{"-sandbox$"}}, "cf-sandbox", nil, false},
+		{"excluded", config.BOSHDiscoveryConfig{Exclude: []string{"^sandbox-"}}, "sandbox-cf", []string{"diego"}, false},
+		{"uses release", config.BOSHDiscoveryConfig{Releases: []string{"diego", "garden-runc"}}, "cells", []string{"bpm", "garden-runc"}, true},
+		{"lacks release", config.BOSHDiscoveryConfig{Releases: []string{"diego"}}, "mysql", []string{"pxc"}, false},
+		{"exclude beats release", config.BOSHDiscoveryConfig{Releases: []string{"diego"}, Exclude: []string{"^old-"}}, "old-cf", []string{"diego"}, false},
+		{"include and release both needed", config.BOSHDiscoveryConfig{Include: []string{"^cf"}, Releases: []string{"diego"}}, "cf", []string{"pxc"}, false},
+	} {
+		conf := test.conf
+		d, err := newDiscovery([]config.BOSHDirectorConfig{{Name: "main", Discover: &conf}})
+		if err != nil {
+			t.Fatalf("%s: could not make filter: %s", test.name, err)
+		}
+		if got := d.filters["main"].matches(test.dep, test.releases); got != test.matches {
+			t.Errorf("%s: expected match to be %t for %s", test.name, test.matches, test.dep)
+		}
+	}
+}
+
+func TestBadDiscoveryRegex(t *testing.T) {
+	_, err := newDiscovery([]config.BOSHDirectorConfig{{Name: "main", Discover: &config.BOSHDiscoveryConfig{Include: []string{"("}}}})
+	if err == nil {
+		t.Errorf("Expected an error for a bad include regex")
+	}
+}
+
+func TestUndiscoveredDeploymentsAreDropped(t *testing.T) {
+	director := newFakeDirector(map[string][]gogobosh.VM{
+		"cf":      {testVM("diego_cell", 0, "10.0.0.1")},
+		"cf-iso":  {testVM("iso_cell", 0, "10.0.1.1")},
+		"mysql":   {testVM("mysql", 0, "10.0.2.1")},
+		"listed":  {testVM("thing", 0, "10.0.3.1")},
+		"by-name": {testVM("other", 0, "10.0.4.1")},
+	})
+	defer director.Close()
+
+	conf := director.directorConfig("main", "listed")
+	conf.Discover = &config.BOSHDiscoveryConfig{Include: []string{"^cf"}}
+	s := newTestSeeker(t, conf)
+
+	err := s.cacheAll()
+	if err != nil {
+		t.Fatalf("Could not cache deployments: %s", err)
+	}
+	_, _, err = s.GetDeploymentVMs("by-name")
+	if err != nil {
+		t.Fatalf("Could not get deployment by name: %s", err)
+	}
+	for _, name := range []string{"cf", "cf-iso", "listed", "by-name"} {
+		if !s.isCached(boshDeployment{Director: "main", Name: name}) {
+			t.Errorf("Expected %s to be cached", name)
+		}
+	}
+	if s.isCached(boshDeployment{Director: "main", Name: "mysql"}) {
+		t.Errorf("Expected mysql to be filtered out of discovery")
+	}
+
+	//cf-iso is deleted from the director, and the next discovery should drop it
+	director.lock.Lock()
+	director.deployments = removeDeployment(director.deployments, "cf-iso")
+	director.lock.Unlock()
+	s.discovery.lock.Lock()
+	_, err = s.discover(conf)
+	s.discovery.lock.Unlock()
+	if err != nil {
+		t.Fatalf("Could not rediscover: %s", err)
+	}
+
+	if s.isCached(boshDeployment{Director: "main", Name: "cf-iso"}) {
+		t.Errorf("Expected cf-iso to be dropped once it was no longer discovered")
+	}
+	for _, name := range []string{"cf", "listed", "by-name"} {
+		if !s.isCached(boshDeployment{Director: "main", Name: name}) {
+			t.Errorf("Expected %s to stay cached", name)
+		}
+	}
+}
+
+func TestGetDeploymentVMsWithoutBOSH(t *testing.T) {
+	s := newTestSeeker(t)
+	_, _, err := s.GetDeploymentVMs("cf")
+	if err == nil {
+		t.Errorf("Expected an error when BOSH isn't configured")
+	}
+}
+
+func removeDeployment(deployments []gogobosh.Deployment, name string) (ret []gogobosh.Deployment) {
+	for _, dep := range deployments {
+		if dep.Name != name {
+			ret = append(ret, dep)
+		}
+	}
+	return
+}
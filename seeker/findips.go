@@ -3,7 +3,13 @@ package seeker
 import (
 	"fmt"
 	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
+	cfclient "github.com/cloudfoundry-community/go-cfclient"
+	"github.com/pkg/errors"
 	"github.com/starkandwayne/goutils/log"
 )
 
@@ -12,29 +18,93 @@ import (
 type AppMeta struct {
 	Name string
 	GUID string
+	//Processes is only filled in when instances are looked up with the v3 API
+	Processes []ProcessMeta
+}
+
+//ProcessMeta has information about one process type of an app, as given by the
+// v3 API
+type ProcessMeta struct {
+	Type string
+	//Instances is how many instances of the process there should be
+	Instances int
+	//Sidecars lists the names of the sidecars running alongside the process
+	Sidecars []string
 }
 
 //AppInstance has information from the CF API about an application
 type AppInstance struct {
+	//Index is the instance index, as used by `cf app` and `cf ssh -i`
+	Index int
+	//State is the state of the instance, such as RUNNING, CRASHED, STARTING, or
+	// DOWN
+	State string
+	//Host is empty if the instance is not running
 	Host string
+	//Port is UnknownPort if the instance is running but the CF API didn't say
+	// which port it listens on
 	Port int
+	//Usage is nil if the instance is not running
+	Usage *InstanceUsage
+	//ProcessType, InternalIP, and Ports are only filled in when instances are
+	// looked up with the v3 API. InternalIP and Ports are empty if the instance
+	// is not running.
+	ProcessType string
+	InternalIP  string
+	Ports       []PortMapping
+}
+
+//PortMapping maps a port on the host of an app instance to the port in its
+// container
+type PortMapping struct {
+	External int
+	Internal int
+}
+
+//UnknownPort is the Port of a running app instance whose port isn't known
+const UnknownPort = -1
+
+//InstanceUsage has the resource usage, quotas, and uptime of a running app
+// instance, as last reported to the CF API. Memory and disk are in bytes.
+type InstanceUsage struct {
+	//CPU is the fraction of one CPU core in use
+	CPU       float64
+	Mem       int
+	MemQuota  int
+	Disk      int
+	DiskQuota int
+	FdsQuota  int
+	Uptime    time.Duration
+	URIs      []string
+	//ReportedAt is when the usage was measured
+	ReportedAt time.Time
+}
+
+//Running returns true if the instance is in the RUNNING state
+func (a AppInstance) Running() bool {
+	return a.State == "RUNNING"
 }
 
 // FindInstances takes the App GUID given and queries the CF API to get the IP
 // addresses of the VMs and listening ports on which the instances of the
-// application are located and returns those. If inputErr is non-nil, the
+// application are located and returns those. Instances that aren't running are
+// returned too, but without a host or port. If inputErr is non-nil, the
 // function will bail early with the given error.
 func (s *Seeker) FindInstances(guid string, inputErr error) (meta *AppMeta, inst []AppInstance, err error) {
 	if inputErr != nil {
 		return nil, nil, inputErr
 	}
 
+	if s.useV3() {
+		return s.findInstancesV3(guid)
+	}
+
 	meta = &AppMeta{}
 
 	log.Debugf("Getting application stats for app with GUID %s from CF API", guid)
 	statsMap, err := s.CF.GetAppStats(guid)
 	if err != nil {
-		err = fmt.Errorf("Error when getting stats for app with GUID `%s` (Is the app running?)", guid)
+		err = errors.Wrapf(err, "Error when getting stats for app with GUID `%s` (Is the app running?)", guid)
 		return
 	}
 	if len(statsMap) == 0 {
@@ -44,19 +114,87 @@ func (s *Seeker) FindInstances(guid string, inputErr error) (meta *AppMeta, inst
 
 	meta.GUID = guid
 
-	for _, stats := range statsMap {
-		stats.Stats.Host, err = canonizeIP(stats.Stats.Host)
+	for key, stats := range statsMap {
+		var instance AppInstance
+		instance, err = appInstanceFromStats(key, stats)
+		if err != nil {
+			return
+		}
+		inst = append(inst, instance)
+
+		if stats.Stats.Name != "" {
+			meta.Name = stats.Stats.Name
+		}
+	}
+
+	//Only running instances report the app name in their stats
+	if meta.Name == "" {
+		log.Debugf("Getting app with GUID %s from CF API", guid)
+		var app cfclient.App
+		app, err = s.CF.GetAppByGuid(guid)
 		if err != nil {
+			err = errors.Wrapf(err, "Error when getting app with GUID `%s`", guid)
 			return
 		}
-		inst = append(inst, AppInstance{Host: stats.Stats.Host, Port: stats.Stats.Port})
+		meta.Name = app.Name
+	}
+
+	return
+}
+
+//appInstanceFromStats makes an AppInstance out of the entry in an app stats
+// response with the given key
+func appInstanceFromStats(key string, stats cfclient.AppStats) (ret AppInstance, err error) {
+	ret.Index, err = strconv.Atoi(key)
+	if err != nil {
+		err = fmt.Errorf("Could not interpret instance index `%s` as a number", key)
+		return
+	}
 
-		meta.Name = stats.Stats.Name
+	ret.State = stats.State
+	if !ret.Running() {
+		return
 	}
 
+	ret.Host, err = canonizeIP(stats.Stats.Host)
+	if err != nil {
+		return
+	}
+	ret.Port = stats.Stats.Port
+	ret.Usage = &InstanceUsage{
+		CPU:        stats.Stats.Usage.CPU,
+		Mem:        stats.Stats.Usage.Mem,
+		MemQuota:   stats.Stats.MemQuota,
+		Disk:       stats.Stats.Usage.Disk,
+		DiskQuota:  stats.Stats.DiskQuota,
+		FdsQuota:   stats.Stats.FdsQuota,
+		Uptime:     time.Duration(stats.Stats.Uptime) * time.Second,
+		URIs:       stats.Stats.Uris,
+		ReportedAt: stats.Stats.Usage.Time.Time,
+	}
 	return
 }
 
+//FindInstancesByName looks up the GUID of the app with the given org, space,
+// and app names, which may be cached, and finds its instances with
+// FindInstances. If the CF API says that there is no app with that GUID, as
+// when the app was deleted and pushed again under the same name since its GUID
+// was cached, the GUID is forgotten and the names are looked up again, once.
+func (s *Seeker) FindInstancesByName(org, space, app string) (meta *AppMeta, inst []AppInstance, err error) {
+	guid, err := s.getAppGUID(org, space, app)
+	if err != nil {
+		return
+	}
+
+	meta, inst, err = s.FindInstances(guid, nil)
+	if !isNotFound(err) {
+		return
+	}
+	log.Debugf("App with GUID %s no longer exists; looking up app `%s` again", guid, app)
+	s.cfcache.forgetGUID(cfCacheApp, guid)
+	return s.FindInstances(s.getAppGUID(org, space, app))
+}
+
 // ByOrgSpaceAndName checks that the given variables are set, erroring if any
 // of them are not, and then looks up the GUID of the app using the CF API.
 func (s *Seeker) ByOrgSpaceAndName(org, space, app string) (retGUID string, err error) {
@@ -70,29 +208,130 @@ func (s *Seeker) ByGUID(appGUID string) (string, error) {
 }
 
 //getAppGUID performs lookups against the CF API to convert org, space, and app
-// names into the target app GUID
+// names into the target app GUID. Names that only differ by case are matched.
 func (s *Seeker) getAppGUID(orgname, spacename, appname string) (guid string, err error) {
-	log.Debugf("Getting org by name (%s) from CF API", orgname)
-	org, err := s.CF.GetOrgByName(orgname)
+	orgGUID, _, err := s.OrgByName(orgname)
+	if err != nil {
+		err = lookupError("org", err)
+		return
+	}
+
+	spaceGUID, _, err := s.SpaceByName(orgGUID, spacename)
+	if err != nil {
+		err = lookupError("space", err)
+		return
+	}
+
+	guid, _, err = s.AppByName(spaceGUID, appname)
+	if err != nil {
+		err = lookupError("app", err)
+		return
+	}
+	return
+}
+
+//ScopedApp is an app along with the names of the space and org it is pushed to
+// and its state. OrgName is only filled in where noted.
+type ScopedApp struct {
+	AppMeta
+	SpaceName string
+	OrgName   string
+	State     string
+}
+
+//ListStartedApps looks up every started app in the given org. If a space name
+// is given, only apps in that space are listed.
+func (s *Seeker) ListStartedApps(orgname, spacename string) (apps []ScopedApp, err error) {
+	orgGUID, _, err := s.OrgByName(orgname)
+	if err != nil {
+		err = lookupError("org", err)
+		return
+	}
+
+	query := url.Values{}
+	query.Set("inline-relations-depth", "1")
+	if spacename != "" {
+		var spaceGUID string
+		spaceGUID, _, err = s.SpaceByName(orgGUID, spacename)
+		if err != nil {
+			err = lookupError("space", err)
+			return
+		}
+		query.Set("q", "space_guid:"+spaceGUID)
+	} else {
+		query.Set("q", "organization_guid:"+orgGUID)
+	}
+
+	log.Debugf("Getting apps with query (%s) from CF API", query.Get("q"))
+	cfApps, err := s.CF.ListAppsByQuery(query)
 	if err != nil {
-		err = fmt.Errorf("While looking up given org: %s", err.Error())
+		err = fmt.Errorf("While listing apps: %s", err.Error())
 		return
 	}
 
-	log.Debugf("Getting space by name (%s) and org GUID (%s) from CF API", spacename, org.Guid)
-	space, err := s.CF.GetSpaceByName(spacename, org.Guid)
+	for _, app := range cfApps {
+		if app.State != "STARTED" {
+			continue
+		}
+		apps = append(apps, ScopedApp{
+			AppMeta:   AppMeta{Name: app.Name, GUID: app.Guid},
+			SpaceName: app.SpaceData.Entity.Name,
+			State:     app.State,
+		})
+	}
+	return
+}
+
+//FindAppsNamed looks up every app with the given name, in any org or space.
+// The org names of the returned apps are filled in.
+func (s *Seeker) FindAppsNamed(appname string) (apps []ScopedApp, err error) {
+	query := url.Values{}
+	query.Set("inline-relations-depth", "2")
+	query.Set("q", "name:"+appname)
+
+	log.Debugf("Getting apps by name (%s) from CF API", appname)
+	cfApps, err := s.CF.ListAppsByQuery(query)
 	if err != nil {
-		err = fmt.Errorf("While looking up given space: %s", err.Error())
+		err = fmt.Errorf("While listing apps: %s", err.Error())
 		return
 	}
 
-	log.Debugf("Getting app by name (%s), space GUID (%s), and org GUID (%s) from CF API", appname, space.Guid, org.Guid)
-	app, err := s.CF.AppByName(appname, space.Guid, org.Guid)
+	for _, app := range cfApps {
+		apps = append(apps, scopedAppFromCF(app))
+	}
+	return
+}
+
+//FindAppWithGUID looks up the app with the given GUID. If the CF API says there
+// is no such app, found is false and no error is returned. The org name of the
+// returned app is filled in.
+func (s *Seeker) FindAppWithGUID(guid string) (app ScopedApp, found bool, err error) {
+	log.Debugf("Getting app with GUID %s from CF API", guid)
+	cfApp, err := s.CF.GetAppByGuid(guid)
 	if err != nil {
-		err = fmt.Errorf("While looking up given app: %s", err.Error())
+		if isNotFound(err) {
+			return app, false, nil
+		}
+		err = fmt.Errorf("While looking up app with GUID `%s`: %s", guid, err.Error())
 		return
 	}
-	return app.Guid, nil
+	return scopedAppFromCF(cfApp), true, nil
+}
+
+func scopedAppFromCF(app cfclient.App) ScopedApp {
+	return ScopedApp{
+		AppMeta:   AppMeta{Name: app.Name, GUID: app.Guid},
+		SpaceName: app.SpaceData.Entity.Name,
+		OrgName:   app.SpaceData.Entity.OrgData.Entity.Name,
+		State:     app.State,
+	}
+}
+
+//isNotFound returns true if the given error is the CF API saying that the
+// requested resource doesn't exist
+func isNotFound(err error) bool {
+	cfErr, isCFErr := errors.Cause(err).(cfclient.CloudFoundryError)
+	return isCFErr && strings.HasSuffix(cfErr.ErrorCode, "NotFound")
 }
 
 func canonizeIP(ip string) (canon string, err error) {
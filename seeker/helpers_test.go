@@ -0,0 +1,141 @@
+package seeker
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cloudfoundry-community/cfseeker/config"
+	cfclient "github.com/cloudfoundry-community/go-cfclient"
+	"github.com/cloudfoundry-community/gogobosh"
+)
+
+//fakeDirector serves the deployments and VMs given to it the way a BOSH
+// director does, and counts the VM listings it is asked for
+type fakeDirector struct {
+	*httptest.Server
+	deployments []gogobosh.Deployment
+	vms         map[string][]gogobosh.VM //keyed by deployment name
+	vmListings  map[string]int
+	lock        sync.Mutex
+	//listingDelay is how long each VM listing takes to be answered
+	listingDelay time.Duration
+}
+
+func newFakeDirector(vms map[string][]gogobosh.VM) *fakeDirector {
+	ret := &fakeDirector{vms: vms, vmListings: map[string]int{}}
+	for name := range vms {
+		ret.deployments = append(ret.deployments, gogobosh.Deployment{Name: name})
+	}
+	ret.Server = httptest.NewServer(http.HandlerFunc(ret.serve))
+	return ret
+}
+
+//Tasks are numbered after the deployment whose VMs they list, by its position
+// in the list of deployments
+func (f *fakeDirector) serve(w http.ResponseWriter, r *http.Request) {
+	if strings.HasSuffix(r.URL.Path, "/vms") {
+		time.Sleep(f.listingDelay)
+	}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	path := r.URL.Path
+	switch {
+	case path == "/info":
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"name":                "fake",
+			"user_authentication": map[string]string{"type": "basic"},
+		})
+	case path == "/deployments":
+		json.NewEncoder(w).Encode(f.deployments)
+	case strings.HasPrefix(path, "/deployments/") && strings.HasSuffix(path, "/vms"):
+		name := strings.TrimSuffix(strings.TrimPrefix(path, "/deployments/"), "/vms")
+		for i, dep := range f.deployments {
+			if dep.Name == name {
+				f.vmListings[name]++
+				json.NewEncoder(w).Encode(gogobosh.Task{ID: i + 1, State: "queued"})
+				return
+			}
+		}
+		w.WriteHeader(404)
+	case strings.HasPrefix(path, "/tasks/") && strings.HasSuffix(path, "/output"):
+		id, _ := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(path, "/tasks/"), "/output"))
+		for _, vm := range f.vms[f.deployments[id-1].Name] {
+			line, _ := json.Marshal(vm)
+			w.Write(append(line, '\n'))
+		}
+	case strings.HasPrefix(path, "/tasks/"):
+		id, _ := strconv.Atoi(strings.TrimPrefix(path, "/tasks/"))
+		json.NewEncoder(w).Encode(gogobosh.Task{ID: id, State: "done"})
+	default:
+		w.WriteHeader(404)
+	}
+}
+
+func (f *fakeDirector) listings(deployment string) int {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.vmListings[deployment]
+}
+
+//directorConfig returns the config of a director with the given name which is
+// served by the fake director
+func (f *fakeDirector) directorConfig(name string, deployments ...string) config.BOSHDirectorConfig {
+	return config.BOSHDirectorConfig{
+		Name:        name,
+		APIAddress:  f.URL,
+		Username:    "admin",
+		Password:    "admin",
+		Deployments: deployments,
+	}
+}
+
+//newTestSeeker makes a Seeker for the given BOSH directors without a CF client
+func newTestSeeker(t *testing.T, directors ...config.BOSHDirectorConfig) *Seeker {
+	s := &Seeker{
+		config:   &config.Config{BOSH: config.BOSHConfig{Directors: directors}, HTTPTimeout: 5},
+		bosh:     map[string]*gogobosh.Client{},
+		names:    newPlacementNames(),
+		listings: newListingCache(),
+		vmcache:  newVMCache(),
+		cfcache:  newCFCache(),
+		crawler:  newCrawler(0),
+		backend:  &cfBackend{},
+
+		guidLookups: make(chan struct{}, defaultWorkers),
+	}
+
+	var err error
+	for _, director := range directors {
+		s.bosh[director.Name], err = s.getBOSHClientFromConfig(director)
+		if err != nil {
+			t.Fatalf("Could not make client for director %s: %s", director.Name, err)
+		}
+	}
+	s.discovery, err = newDiscovery(s.directors())
+	if err != nil {
+		t.Fatalf("Could not set up discovery: %s", err)
+	}
+	return s
+}
+
+//useFakeCF points the CF client of the given Seeker at a server which answers
+// CF API requests with the given handler. The server must be closed when done.
+func useFakeCF(s *Seeker, handler http.HandlerFunc) *httptest.Server {
+	srv := httptest.NewServer(handler)
+	s.CF = &cfclient.Client{Config: cfclient.Config{
+		ApiAddress: srv.URL,
+		HttpClient: &http.Client{},
+		UserAgent:  "cfseeker-test",
+	}}
+	return srv
+}
+
+func testVM(job string, index int, ips ...string) gogobosh.VM {
+	return gogobosh.VM{JobName: job, Index: index, IPs: ips, ID: job + "-" + strconv.Itoa(index)}
+}
@@ -0,0 +1,237 @@
+package seeker
+
+import (
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	cfclient "github.com/cloudfoundry-community/go-cfclient"
+	"github.com/starkandwayne/goutils/log"
+)
+
+//How many app stats requests to have in flight at once while looking up many
+// apps, unless configured otherwise
+const defaultWorkers = 8
+
+//InstanceIndex is a foundation-wide record of the instances of every started
+// app and where the running ones are placed, as reported by the CF API
+type InstanceIndex struct {
+	Instances []IndexedInstance
+	//BuiltAt is when the index finished being built
+	BuiltAt time.Time
+	byHost  map[string][]int
+	byAddr  map[string]int
+	byApp   map[string][]int
+	byName  map[string]string
+	//byInternalIP is only filled in when instances are looked up with the v3 API
+	byInternalIP map[string]int
+}
+
+//IndexedInstance is a single app instance as recorded in an InstanceIndex
+type IndexedInstance struct {
+	AppInstance
+	App       AppMeta
+	OrgName   string
+	OrgGUID   string
+	SpaceName string
+	SpaceGUID string
+}
+
+//BuildInstanceIndex lists every app in the foundation and gets the stats of
+// each started app to record where all of their running instances are. Apps
+// whose stats can't be fetched (because they stopped or are still staging, for
+// example) are skipped.
+func (s *Seeker) BuildInstanceIndex() (index *InstanceIndex, err error) {
+	log.Debugf("Listing all apps from CF API")
+	apps, err := s.CF.ListApps()
+	if err != nil {
+		return
+	}
+
+	jobs := make(chan cfclient.App)
+	results := make(chan []IndexedInstance)
+	var wg sync.WaitGroup
+	for i := s.Workers(); i > 0; i-- {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for app := range jobs {
+				results <- s.indexApp(app)
+			}
+		}()
+	}
+
+	go func() {
+		for _, app := range apps {
+			if app.State == "STARTED" {
+				jobs <- app
+			}
+		}
+		close(jobs)
+		wg.Wait()
+		close(results)
+	}()
+
+	index = &InstanceIndex{
+		byHost: map[string][]int{},
+		byAddr: map[string]int{},
+		byApp:  map[string][]int{},
+		byName: map[string]string{},
+
+		byInternalIP: map[string]int{},
+	}
+	for instances := range results {
+		for _, inst := range instances {
+			if inst.Running() {
+				index.byHost[inst.Host] = append(index.byHost[inst.Host], len(index.Instances))
+				if inst.Port != UnknownPort {
+					index.byAddr[joinAddr(inst.Host, inst.Port)] = len(index.Instances)
+				}
+				for _, port := range inst.Ports {
+					index.byAddr[joinAddr(inst.Host, port.External)] = len(index.Instances)
+				}
+				if inst.InternalIP != "" {
+					index.byInternalIP[inst.InternalIP] = len(index.Instances)
+				}
+			}
+			index.byApp[inst.App.GUID] = append(index.byApp[inst.App.GUID], len(index.Instances))
+			index.byName[joinNames(inst.OrgName, inst.SpaceName, inst.App.Name)] = inst.App.GUID
+			index.Instances = append(index.Instances, inst)
+		}
+	}
+	index.BuiltAt = time.Now()
+	log.Debugf("Indexed %d instances of %d apps", len(index.Instances), len(apps))
+	return
+}
+
+func (s *Seeker) indexApp(app cfclient.App) (ret []IndexedInstance) {
+	meta := AppMeta{Name: app.Name, GUID: app.Guid}
+	instances, err := s.instancesToIndex(&meta)
+	if err != nil {
+		log.Debugf("Skipping app with GUID %s: %s", app.Guid, err.Error())
+		return
+	}
+
+	space := app.SpaceData.Entity
+	for _, instance := range instances {
+		ret = append(ret, IndexedInstance{
+			AppInstance: instance,
+			App:         meta,
+			OrgName:     space.OrgData.Entity.Name,
+			OrgGUID:     space.OrgData.Entity.Guid,
+			SpaceName:   space.Name,
+			SpaceGUID:   space.Guid,
+		})
+	}
+	return
+}
+
+//instancesToIndex gets the instances of the given app with whichever version of
+// the CF API is in use, filling in its processes if that is v3. Instances whose
+// stats can't be read are skipped.
+func (s *Seeker) instancesToIndex(meta *AppMeta) (ret []AppInstance, err error) {
+	if s.useV3() {
+		meta.Processes, ret, err = s.v3Instances(meta.GUID)
+		return
+	}
+
+	log.Debugf("Getting application stats for app with GUID %s from CF API", meta.GUID)
+	statsMap, err := s.CF.GetAppStats(meta.GUID)
+	if err != nil {
+		return
+	}
+
+	for key, stats := range statsMap {
+		instance, err := appInstanceFromStats(key, stats)
+		if err != nil {
+			log.Debugf("Skipping instance `%s` of app with GUID %s: %s", key, meta.GUID, err.Error())
+			continue
+		}
+		ret = append(ret, instance)
+	}
+	return
+}
+
+//OnHost returns the indexed instances that are running on the VM with the
+// given IP address
+func (i *InstanceIndex) OnHost(host string) (ret []IndexedInstance) {
+	host, err := canonizeIP(host)
+	if err != nil {
+		return
+	}
+
+	for _, idx := range i.byHost[host] {
+		ret = append(ret, i.Instances[idx])
+	}
+	return
+}
+
+//At returns the indexed instance that is listening on the given host and port.
+// If no instance in the index is listening there, found is false.
+func (i *InstanceIndex) At(host string, port int) (inst IndexedInstance, found bool) {
+	host, err := canonizeIP(host)
+	if err != nil {
+		return
+	}
+
+	idx, found := i.byAddr[joinAddr(host, port)]
+	if found {
+		inst = i.Instances[idx]
+	}
+	return
+}
+
+//WithInternalIP returns the indexed instance whose container has the given
+// overlay IP address. If no instance in the index has that IP, found is false.
+func (i *InstanceIndex) WithInternalIP(ip string) (inst IndexedInstance, found bool) {
+	ip, err := canonizeIP(ip)
+	if err != nil {
+		return
+	}
+
+	idx, found := i.byInternalIP[ip]
+	if found {
+		inst = i.Instances[idx]
+	}
+	return
+}
+
+//KnowsInternalIPs returns true if any instance in the index has its container IP
+// address recorded, which is only the case if the index was built with the v3
+// API
+func (i *InstanceIndex) KnowsInternalIPs() bool {
+	return len(i.byInternalIP) > 0
+}
+
+//ForApp returns the metadata and instances of the app with the given GUID. If
+// the app isn't in the index, found is false.
+func (i *InstanceIndex) ForApp(guid string) (meta *AppMeta, inst []AppInstance, found bool) {
+	idxs, found := i.byApp[guid]
+	if !found {
+		return
+	}
+
+	meta = &AppMeta{}
+	*meta = i.Instances[idxs[0]].App
+	for _, idx := range idxs {
+		inst = append(inst, i.Instances[idx].AppInstance)
+	}
+	return
+}
+
+//AppGUID returns the GUID of the app with the given org, space and app names.
+// If the app isn't in the index, found is false.
+func (i *InstanceIndex) AppGUID(org, space, app string) (guid string, found bool) {
+	guid, found = i.byName[joinNames(org, space, app)]
+	return
+}
+
+func joinNames(org, space, app string) string {
+	return strings.Join([]string{org, space, app}, "/")
+}
+
+func joinAddr(host string, port int) string {
+	return net.JoinHostPort(host, strconv.Itoa(port))
+}
@@ -0,0 +1,302 @@
+package seeker
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/starkandwayne/goutils/log"
+)
+
+//maxSuggestions is how many of the closest names are suggested when a name
+// lookup misses
+const maxSuggestions = 3
+
+//NameNotFoundError is returned when no org, space, app, or service instance has
+// the given name. Names of orgs, spaces, and apps are also matched ignoring
+// case. Suggestions holds the closest names that do exist, with the closest
+// first.
+type NameNotFoundError struct {
+	//Kind is org, space, app, or service instance
+	Kind        string
+	Name        string
+	Suggestions []string
+}
+
+func (e NameNotFoundError) Error() string {
+	message := fmt.Sprintf("Unable to find %s `%s`", e.Kind, e.Name)
+	if len(e.Suggestions) > 0 {
+		message = fmt.Sprintf("%s. Did you mean: `%s`?", message, strings.Join(e.Suggestions, "`, `"))
+	}
+	return message
+}
+
+//lookupError describes an error from looking up the given kind of thing by
+// name. A NameNotFoundError is returned as it is, so that callers can still
+// tell that the name doesn't exist.
+func lookupError(kind string, err error) error {
+	if _, notFound := err.(NameNotFoundError); notFound {
+		return err
+	}
+	return fmt.Errorf("While looking up given %s: %s", kind, err.Error())
+}
+
+//namedResource is an org, space, or app that could be the one a name lookup
+// was after
+type namedResource struct {
+	Name string
+	GUID string
+}
+
+//OrgByName looks up the org with the given name. If no org has exactly that
+// name, an org whose name only differs by case is used instead. If there is
+// none of those either, a NameNotFoundError suggesting the closest org names is
+// returned. The name the org really has is returned along with its GUID.
+func (s *Seeker) OrgByName(name string) (guid, realName string, err error) {
+	if entry, found := s.cfcache.get(cfNameKey(cfCacheOrg, "", name)); found {
+		return entry.GUID, entry.Name, nil
+	}
+	guid, realName, err = s.orgByName(name)
+	if err == nil {
+		s.cacheOrg(guid, realName, name)
+	}
+	return
+}
+
+func (s *Seeker) orgByName(name string) (guid, realName string, err error) {
+	log.Debugf("Getting org by name (%s) from CF API", name)
+	orgs, err := s.CF.ListOrgsByQuery(url.Values{"q": []string{"name:" + name}})
+	if err != nil {
+		return
+	}
+	if len(orgs) > 0 {
+		return orgs[0].Guid, orgs[0].Name, nil
+	}
+
+	log.Debugf("No org named `%s`; listing every org to match without case", name)
+	all, err := s.CF.ListOrgs()
+	if err != nil {
+		return
+	}
+	candidates := make([]namedResource, 0, len(all))
+	for _, o := range all {
+		candidates = append(candidates, namedResource{Name: o.Name, GUID: o.Guid})
+	}
+	match, err := matchName("org", name, candidates)
+	return match.GUID, match.Name, err
+}
+
+//SpaceByName looks up the space with the given name in the org with the given
+// GUID, falling back the same way OrgByName does
+func (s *Seeker) SpaceByName(orgGUID, name string) (guid, realName string, err error) {
+	if entry, found := s.cfcache.get(cfNameKey(cfCacheSpace, orgGUID, name)); found {
+		return entry.GUID, entry.Name, nil
+	}
+	guid, realName, err = s.spaceByName(orgGUID, name)
+	if err == nil {
+		s.cacheSpace(guid, realName, orgGUID, name)
+	}
+	return
+}
+
+func (s *Seeker) spaceByName(orgGUID, name string) (guid, realName string, err error) {
+	log.Debugf("Getting space by name (%s) and org GUID (%s) from CF API", name, orgGUID)
+	query := url.Values{}
+	query.Add("q", "organization_guid:"+orgGUID)
+	query.Add("q", "name:"+name)
+	spaces, err := s.CF.ListSpacesByQuery(query)
+	if err != nil {
+		return
+	}
+	if len(spaces) > 0 {
+		return spaces[0].Guid, spaces[0].Name, nil
+	}
+
+	log.Debugf("No space named `%s`; listing every space in the org to match without case", name)
+	all, err := s.CF.ListSpacesByQuery(url.Values{"q": []string{"organization_guid:" + orgGUID}})
+	if err != nil {
+		return
+	}
+	candidates := make([]namedResource, 0, len(all))
+	for _, sp := range all {
+		candidates = append(candidates, namedResource{Name: sp.Name, GUID: sp.Guid})
+	}
+	match, err := matchName("space", name, candidates)
+	return match.GUID, match.Name, err
+}
+
+//AppByName looks up the app with the given name in the space with the given
+// GUID, falling back the same way OrgByName does
+func (s *Seeker) AppByName(spaceGUID, name string) (guid, realName string, err error) {
+	if entry, found := s.cfcache.get(cfNameKey(cfCacheApp, spaceGUID, name)); found {
+		return entry.GUID, entry.Name, nil
+	}
+	guid, realName, err = s.appByName(spaceGUID, name)
+	if err == nil {
+		s.cacheApp(guid, realName, spaceGUID, name)
+	}
+	return
+}
+
+func (s *Seeker) appByName(spaceGUID, name string) (guid, realName string, err error) {
+	log.Debugf("Getting app by name (%s) and space GUID (%s) from CF API", name, spaceGUID)
+	query := url.Values{}
+	query.Add("q", "space_guid:"+spaceGUID)
+	query.Add("q", "name:"+name)
+	apps, err := s.CF.ListAppsByQuery(query)
+	if err != nil {
+		return
+	}
+	if len(apps) > 0 {
+		return apps[0].Guid, apps[0].Name, nil
+	}
+
+	log.Debugf("No app named `%s`; listing every app in the space to match without case", name)
+	all, err := s.CF.ListAppsByQuery(url.Values{"q": []string{"space_guid:" + spaceGUID}})
+	if err != nil {
+		return
+	}
+	candidates := make([]namedResource, 0, len(all))
+	for _, a := range all {
+		candidates = append(candidates, namedResource{Name: a.Name, GUID: a.Guid})
+	}
+	match, err := matchName("app", name, candidates)
+	return match.GUID, match.Name, err
+}
+
+//matchName returns the candidate whose name is the given name ignoring case.
+// If there isn't exactly one of those, a NameNotFoundError is returned with the
+// closest candidate names as suggestions.
+func matchName(kind, name string, candidates []namedResource) (ret namedResource, err error) {
+	var matches []namedResource
+	for _, candidate := range candidates {
+		if strings.EqualFold(candidate.Name, name) {
+			matches = append(matches, candidate)
+		}
+	}
+	if len(matches) == 1 {
+		log.Debugf("Matched %s `%s` to `%s` ignoring case", kind, name, matches[0].Name)
+		return matches[0], nil
+	}
+
+	names := make([]string, 0, len(candidates))
+	for _, candidate := range candidates {
+		names = append(names, candidate.Name)
+	}
+	return ret, NameNotFoundError{Kind: kind, Name: name, Suggestions: closestNames(name, names)}
+}
+
+//closestNames ranks the given names by how few single character edits, ignoring
+// case, turn them into the target, and returns the best few. Names needing too
+// many edits to be a plausible typo are left out, unless one contains the other.
+func closestNames(target string, names []string) []string {
+	target = strings.ToLower(target)
+	var ranked rankedNames
+	for _, name := range names {
+		lower := strings.ToLower(name)
+		distance := editDistance(target, lower)
+		if distance > len(target)/3+1 && !strings.Contains(lower, target) && !strings.Contains(target, lower) {
+			continue
+		}
+		ranked = append(ranked, rankedName{name: name, distance: distance})
+	}
+	sort.Sort(ranked)
+
+	var ret []string
+	for i := 0; i < len(ranked) && i < maxSuggestions; i++ {
+		ret = append(ret, ranked[i].name)
+	}
+	return ret
+}
+
+type rankedName struct {
+	name     string
+	distance int
+}
+
+//rankedNames sorts names by edit distance, then alphabetically
+type rankedNames []rankedName
+
+func (r rankedNames) Len() int      { return len(r) }
+func (r rankedNames) Swap(i, j int) { r[i], r[j] = r[j], r[i] }
+func (r rankedNames) Less(i, j int) bool {
+	if r[i].distance != r[j].distance {
+		return r[i].distance < r[j].distance
+	}
+	return r[i].name < r[j].name
+}
+
+//editDistance is the Levenshtein distance between a and b
+func editDistance(a, b string) int {
+	ar, br := []rune(a), []rune(b)
+	prev := make([]int, len(br)+1)
+	cur := make([]int, len(br)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ar); i++ {
+		cur[0] = i
+		for j := 1; j <= len(br); j++ {
+			cost := 1
+			if ar[i-1] == br[j-1] {
+				cost = 0
+			}
+			cur[j] = minInt(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(br)]
+}
+
+func minInt(first int, rest ...int) int {
+	for _, n := range rest {
+		if n < first {
+			first = n
+		}
+	}
+	return first
+}
+
+//SearchApps looks up every app, in any org or space, whose name matches the
+// given pattern ignoring case. A pattern containing *, ?, or [ is matched as a
+// glob against the whole name. Any other pattern matches names containing it.
+// The org names of the returned apps are filled in. The listing of every app is
+// kept for the listing TTL, so that each search doesn't list them again.
+func (s *Seeker) SearchApps(pattern string) (apps []ScopedApp, err error) {
+	pattern = strings.ToLower(pattern)
+	glob := strings.ContainsAny(pattern, "*?[")
+	if glob {
+		if _, err = path.Match(pattern, ""); err != nil {
+			err = fmt.Errorf("Invalid search pattern `%s`: %s", pattern, err.Error())
+			return
+		}
+	}
+
+	all, err := s.listAllApps()
+	if err != nil {
+		err = fmt.Errorf("While listing apps: %s", err.Error())
+		return
+	}
+
+	for _, app := range all {
+		var matched bool
+		matched, err = nameMatches(pattern, glob, strings.ToLower(app.Name))
+		if err != nil {
+			err = fmt.Errorf("Invalid search pattern `%s`: %s", pattern, err.Error())
+			return nil, err
+		}
+		if matched {
+			apps = append(apps, app)
+		}
+	}
+	return
+}
+
+func nameMatches(pattern string, glob bool, name string) (bool, error) {
+	if !glob {
+		return strings.Contains(name, pattern), nil
+	}
+	return path.Match(pattern, name)
+}
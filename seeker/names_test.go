@@ -0,0 +1,141 @@
+package seeker
+
+import (
+	"net/http"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestEditDistance(t *testing.T) {
+	for _, test := range []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"", "abc", 3},
+		{"abc", "", 3},
+		{"dev", "dev", 0},
+		{"dev", "dve", 2},
+		{"kitten", "sitting", 3},
+		{"prod", "prd", 1},
+		{"prod", "prods", 1},
+		{"héllo", "hello", 1},
+	} {
+		if got := editDistance(test.a, test.b); got != test.want {
+			t.Errorf("editDistance(%q, %q): expected %d, got %d", test.a, test.b, test.want, got)
+		}
+	}
+}
+
+func TestClosestNames(t *testing.T) {
+	names := []string{"develop", "dev2", "Dev", "production", "staging", "qa"}
+	for _, test := range []struct {
+		target string
+		want   []string
+	}{
+		//Case is ignored, and closer names come first
+		{"dev", []string{"Dev", "dev2", "develop"}},
+		{"DEV1", []string{"Dev", "dev2"}},
+		{"prod", []string{"production"}},
+		{"stagign", []string{"staging"}},
+		//Names needing too many edits are left out
+		{"sandbox", nil},
+		//Ties are broken alphabetically
+		{"qb", []string{"qa"}},
+	} {
+		if got := closestNames(test.target, names); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("closestNames(%q): expected %v, got %v", test.target, test.want, got)
+		}
+	}
+
+	tied := closestNames("ab", []string{"ac", "aa", "ad", "ae"})
+	if want := []string{"aa", "ac", "ad"}; !reflect.DeepEqual(tied, want) {
+		t.Errorf("Expected names the same distance away in alphabetical order, limited to %d, got %v", maxSuggestions, tied)
+	}
+}
+
+func TestMatchName(t *testing.T) {
+	candidates := []namedResource{
+		{Name: "My-App", GUID: "1"},
+		{Name: "my-app-worker", GUID: "2"},
+		{Name: "Twin", GUID: "3"},
+		{Name: "twin", GUID: "4"},
+	}
+	for _, test := range []struct {
+		name        string
+		wantGUID    string
+		suggestions []string
+	}{
+		{name: "my-app", wantGUID: "1"},
+		{name: "MY-APP-WORKER", wantGUID: "2"},
+		//More than one name matches ignoring case, so none is picked
+		{name: "TWIN", suggestions: []string{"Twin", "twin"}},
+		{name: "my-ap", suggestions: []string{"My-App", "my-app-worker"}},
+		{name: "nothing-like-it"},
+	} {
+		match, err := matchName("app", test.name, candidates)
+		if test.wantGUID != "" {
+			if err != nil || match.GUID != test.wantGUID {
+				t.Errorf("matchName(%q): expected GUID %s, got %+v, %v", test.name, test.wantGUID, match, err)
+			}
+			continue
+		}
+
+		notFound, isNotFound := err.(NameNotFoundError)
+		if !isNotFound {
+			t.Errorf("matchName(%q): expected a NameNotFoundError, got %v", test.name, err)
+			continue
+		}
+		if notFound.Kind != "app" || notFound.Name != test.name || !reflect.DeepEqual(notFound.Suggestions, test.suggestions) {
+			t.Errorf("matchName(%q): expected suggestions %v, got %+v", test.name, test.suggestions, notFound)
+		}
+	}
+}
+
+func TestSearchApps(t *testing.T) {
+	var listings int
+	s := newTestSeeker(t)
+	srv := useFakeCF(s, func(w http.ResponseWriter, r *http.Request) {
+		listings++
+		w.Write([]byte(`{"total_results": 3, "resources": [
+			{"metadata": {"guid": "1"}, "entity": {"name": "Billing-Worker"}},
+			{"metadata": {"guid": "2"}, "entity": {"name": "billing-web"}},
+			{"metadata": {"guid": "3"}, "entity": {"name": "reports"}}
+		]}`))
+	})
+	defer srv.Close()
+
+	for _, test := range []struct {
+		pattern string
+		want    []string
+	}{
+		{"BILLING", []string{"Billing-Worker", "billing-web"}},
+		{"*-worker", []string{"Billing-Worker"}},
+		{"billing-w?b", []string{"billing-web"}},
+		{"report", []string{"reports"}},
+		{"report?", []string{"reports"}},
+		{"nothing", nil},
+	} {
+		apps, err := s.SearchApps(test.pattern)
+		if err != nil {
+			t.Fatalf("Could not search for %q: %s", test.pattern, err)
+		}
+		var got []string
+		for _, app := range apps {
+			got = append(got, app.Name)
+		}
+		sort.Strings(got)
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("SearchApps(%q): expected %v, got %v", test.pattern, test.want, got)
+		}
+	}
+	if listings != 1 {
+		t.Errorf("Expected apps to be listed once for every search, but they were listed %d times", listings)
+	}
+
+	_, err := s.SearchApps("[billing")
+	if err == nil {
+		t.Errorf("Expected an invalid glob to fail")
+	}
+}
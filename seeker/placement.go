@@ -0,0 +1,187 @@
+package seeker
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/starkandwayne/goutils/log"
+)
+
+//Placement describes where Diego should place the instances of an app
+type Placement struct {
+	Stack string
+	//IsolationSegment is empty if the app runs on the shared cells
+	IsolationSegment string
+	//PlacementTags are the tags a cell must have for instances of the app to be
+	// placed on it. There are none for apps on the shared cells.
+	PlacementTags []string
+}
+
+//placementNames remembers the names of stacks and isolation segments, which
+// rarely change, keyed by GUID
+type placementNames struct {
+	stacks   map[string]string
+	segments map[string]string
+	lock     sync.Mutex
+}
+
+func newPlacementNames() *placementNames {
+	return &placementNames{
+		stacks:   map[string]string{},
+		segments: map[string]string{},
+	}
+}
+
+//AppPlacement looks up the stack of the app with the given GUID and the
+// isolation segment of the space it is pushed to. If the space has no isolation
+// segment of its own, that of its org is used.
+func (s *Seeker) AppPlacement(guid string) (ret *Placement, err error) {
+	log.Debugf("Getting app with GUID %s from CF API", guid)
+	app, err := s.CF.GetAppByGuid(guid)
+	if err != nil {
+		err = fmt.Errorf("Error when getting app with GUID `%s`: %s", guid, err.Error())
+		return
+	}
+
+	ret = &Placement{}
+	ret.Stack, err = s.stackName(app.StackGuid)
+	if err != nil {
+		return nil, err
+	}
+
+	segmentGUID, err := s.isolationSegmentGUID(app.SpaceGuid)
+	if err != nil {
+		return nil, err
+	}
+	if segmentGUID == "" {
+		return
+	}
+
+	ret.IsolationSegment, err = s.isolationSegmentName(segmentGUID)
+	if err != nil {
+		return nil, err
+	}
+	ret.PlacementTags = []string{ret.IsolationSegment}
+	return
+}
+
+//isolationSegmentGUID returns the GUID of the isolation segment that apps in the
+// space with the given GUID run on, or an empty string for the shared segment
+func (s *Seeker) isolationSegmentGUID(spaceGUID string) (string, error) {
+	log.Debugf("Getting space with GUID %s from CF API", spaceGUID)
+	var space struct {
+		Entity struct {
+			OrgGUID     string `json:"organization_guid"`
+			SegmentGUID string `json:"isolation_segment_guid"`
+		} `json:"entity"`
+	}
+	err := s.cfGet("/v2/spaces/"+spaceGUID, &space)
+	if err != nil {
+		return "", fmt.Errorf("Error when getting space with GUID `%s`: %s", spaceGUID, err.Error())
+	}
+	if space.Entity.SegmentGUID != "" {
+		return space.Entity.SegmentGUID, nil
+	}
+
+	log.Debugf("Getting org with GUID %s from CF API", space.Entity.OrgGUID)
+	var org struct {
+		Entity struct {
+			SegmentGUID string `json:"default_isolation_segment_guid"`
+		} `json:"entity"`
+	}
+	err = s.cfGet("/v2/organizations/"+space.Entity.OrgGUID, &org)
+	if err != nil {
+		return "", fmt.Errorf("Error when getting org with GUID `%s`: %s", space.Entity.OrgGUID, err.Error())
+	}
+	return org.Entity.SegmentGUID, nil
+}
+
+//stackName returns the name of the stack with the given GUID. The lock isn't
+// held while the CF API is asked, so that one slow lookup doesn't hold up the
+// others.
+func (s *Seeker) stackName(guid string) (name string, err error) {
+	n := s.names
+	n.lock.Lock()
+	cached, found := n.stacks[guid]
+	n.lock.Unlock()
+	if found {
+		return cached, nil
+	}
+
+	log.Debugf("Getting stack with GUID %s from CF API", guid)
+	var stack struct {
+		Entity struct {
+			Name string `json:"name"`
+		} `json:"entity"`
+	}
+	err = s.cfGet("/v2/stacks/"+guid, &stack)
+	if err != nil {
+		return "", fmt.Errorf("Error when getting stack with GUID `%s`: %s", guid, err.Error())
+	}
+	n.lock.Lock()
+	n.stacks[guid] = stack.Entity.Name
+	n.lock.Unlock()
+	return stack.Entity.Name, nil
+}
+
+//isolationSegmentName returns the name of the isolation segment with the given
+// GUID, without holding the lock while the CF API is asked, as stackName does
+func (s *Seeker) isolationSegmentName(guid string) (name string, err error) {
+	n := s.names
+	n.lock.Lock()
+	cached, found := n.segments[guid]
+	n.lock.Unlock()
+	if found {
+		return cached, nil
+	}
+
+	log.Debugf("Getting isolation segment with GUID %s from CF API", guid)
+	segment, err := s.CF.GetIsolationSegmentByGUID(guid)
+	if err != nil {
+		return "", fmt.Errorf("Error when getting isolation segment with GUID `%s`: %s", guid, err.Error())
+	}
+	n.lock.Lock()
+	n.segments[guid] = segment.Name
+	n.lock.Unlock()
+	return segment.Name, nil
+}
+
+//SegmentsMapped returns true if any isolation segment is mapped to a BOSH
+// deployment in the config
+func (s *Seeker) SegmentsMapped() bool {
+	for _, d := range s.directors() {
+		if len(d.IsolationSegments) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+//SegmentDeployment returns the BOSH director and deployment that the given
+// isolation segment is mapped to in the config. found is false if the segment
+// isn't mapped to any deployment.
+func (s *Seeker) SegmentDeployment(segment string) (director, deployment string, found bool) {
+	for _, d := range s.directors() {
+		if deployment, found = d.IsolationSegments[segment]; found {
+			return d.Name, deployment, true
+		}
+	}
+	return "", "", false
+}
+
+//DeploymentSegment returns the isolation segment that the given deployment on
+// the given BOSH director is mapped to in the config. found is false if the
+// deployment isn't mapped to any segment, which means it hosts the shared cells.
+func (s *Seeker) DeploymentSegment(director, deployment string) (segment string, found bool) {
+	for _, d := range s.directors() {
+		if d.Name != director {
+			continue
+		}
+		for segment, mapped := range d.IsolationSegments {
+			if mapped == deployment {
+				return segment, true
+			}
+		}
+	}
+	return "", false
+}
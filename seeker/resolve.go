@@ -0,0 +1,171 @@
+package seeker
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	cfclient "github.com/cloudfoundry-community/go-cfclient"
+	"github.com/starkandwayne/goutils/log"
+)
+
+//guidKinds are every kind of resource that a GUID is looked up as when it is
+// resolved
+var guidKinds = append([]resourceKind{
+	{ResourceTypeApp, "/v2/apps/"},
+	{ResourceTypeSpace, "/v2/spaces/"},
+	{ResourceTypeOrg, "/v2/organizations/"},
+}, resourceKinds...)
+
+//UpstreamError is returned when a GUID couldn't be resolved because the CF API
+// failed to answer whether it is some type of resource, as opposed to saying
+// that it isn't
+type UpstreamError struct {
+	GUID string
+	//Failures describes each lookup that failed
+	Failures []string
+}
+
+func (e UpstreamError) Error() string {
+	return fmt.Sprintf("Could not look up GUID `%s`: %s", e.GUID, strings.Join(e.Failures, "; "))
+}
+
+//ResolveGUID looks up the GUID as every type of resource at once, and takes the
+// first type the CF API has it as, cancelling the other lookups. found is false
+// if the CF API says that the GUID is none of them. If it isn't found but some
+// lookups failed, including because the user isn't allowed to see the GUID as
+// some type, an UpstreamError is returned, since the GUID might have been one
+// of those types. At most cf.concurrency lookups are made at once, counting
+// those of every GUID being resolved.
+//
+//Apps, spaces, and orgs are given with only their name and the GUID of the
+// space or org they are in, and are cached as if looked up by GUID. Other types
+// of resource are followed to what they belong to.
+func (s *Seeker) ResolveGUID(guid string) (ret *Resource, found bool, err error) {
+	if ret, found = s.cachedGUID(guid); found {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	results := make(chan guidLookup, len(guidKinds))
+	for _, kind := range guidKinds {
+		go func(kind resourceKind) {
+			results <- s.lookupGUIDAs(ctx, kind, guid)
+		}(kind)
+	}
+
+	var failures []string
+	for range guidKinds {
+		result := <-results
+		if result.err == nil {
+			log.Debugf("GUID %s is a %s; cancelling the other lookups", guid, result.kind.Type)
+			cancel()
+			ret, err = s.resolvedResource(result.kind.Type, guid, result.entity)
+			return ret, err == nil, err
+		}
+		if failure := result.failure(); failure != "" {
+			failures = append(failures, failure)
+		}
+	}
+	return nil, false, upstreamError(guid, failures)
+}
+
+//guidLookup is the outcome of looking up a GUID as one kind of resource
+type guidLookup struct {
+	kind   resourceKind
+	entity v2Entity
+	err    error
+}
+
+//lookupGUIDAs looks up the GUID as the given kind of resource once one of the
+// seeker's GUID lookup slots is free
+func (s *Seeker) lookupGUIDAs(ctx context.Context, kind resourceKind, guid string) guidLookup {
+	select {
+	case s.guidLookups <- struct{}{}:
+		defer func() { <-s.guidLookups }()
+	case <-ctx.Done():
+		return guidLookup{kind: kind, err: ctx.Err()}
+	}
+
+	log.Debugf("Getting %s with GUID %s from CF API", kind.Type, guid)
+	var resp struct {
+		Entity v2Entity `json:"entity"`
+	}
+	err := s.cfGetContext(ctx, kind.Path+guid, &resp)
+	return guidLookup{kind: kind, entity: resp.Entity, err: err}
+}
+
+//failure describes why the lookup failed, or is empty if it failed because the
+// GUID isn't that kind of resource
+func (l guidLookup) failure() string {
+	if isNotFound(l.err) {
+		return ""
+	}
+	if isForbidden(l.err) {
+		log.Debugf("Not allowed to get %s: %s", l.kind.Type, l.err.Error())
+		return fmt.Sprintf("%s lookup was forbidden: %s", l.kind.Type, l.err.Error())
+	}
+	log.Debugf("Could not get %s: %s", l.kind.Type, l.err.Error())
+	return fmt.Sprintf("%s lookup failed: %s", l.kind.Type, l.err.Error())
+}
+
+//upstreamError returns an UpstreamError if there were any failures
+func upstreamError(guid string, failures []string) error {
+	if len(failures) > 0 {
+		return UpstreamError{GUID: guid, Failures: failures}
+	}
+	return nil
+}
+
+//isForbidden returns true if the given error is the CF API saying that the user
+// isn't allowed to see the requested resource
+func isForbidden(err error) bool {
+	switch e := err.(type) {
+	case cfclient.CloudFoundryError:
+		return e.ErrorCode == "CF-NotAuthorized"
+	case cfStatusError:
+		return e.Status == http.StatusForbidden
+	}
+	return false
+}
+
+//cachedGUID returns the app, space, or org with the given GUID if it is in the
+// CF name and GUID cache
+func (s *Seeker) cachedGUID(guid string) (ret *Resource, found bool) {
+	entry, key, found := s.cfcache.getAny(
+		cfGUIDKey(cfCacheApp, guid), cfGUIDKey(cfCacheSpace, guid), cfGUIDKey(cfCacheOrg, guid))
+	if !found {
+		return
+	}
+
+	ret = &Resource{GUID: guid, Name: entry.Name}
+	switch key {
+	case cfGUIDKey(cfCacheApp, guid):
+		ret.Type, ret.SpaceGUID = ResourceTypeApp, entry.ParentGUID
+	case cfGUIDKey(cfCacheSpace, guid):
+		ret.Type, ret.OrgGUID = ResourceTypeSpace, entry.ParentGUID
+	default:
+		ret.Type = ResourceTypeOrg
+	}
+	return
+}
+
+//resolvedResource makes a Resource of the given type out of its v2 entity,
+// caching apps, spaces, and orgs
+func (s *Seeker) resolvedResource(resourceType, guid string, entity v2Entity) (*Resource, error) {
+	switch resourceType {
+	case ResourceTypeApp:
+		s.cacheApp(guid, entity.Name, entity.SpaceGUID, entity.Name)
+		return &Resource{Type: resourceType, GUID: guid, Name: entity.Name, SpaceGUID: entity.SpaceGUID}, nil
+	case ResourceTypeSpace:
+		s.cacheSpace(guid, entity.Name, entity.OrgGUID, entity.Name)
+		return &Resource{Type: resourceType, GUID: guid, Name: entity.Name, OrgGUID: entity.OrgGUID}, nil
+	case ResourceTypeOrg:
+		s.cacheOrg(guid, entity.Name, entity.Name)
+		return &Resource{Type: resourceType, GUID: guid, Name: entity.Name}, nil
+	}
+	return s.resourceFromEntity(resourceType, guid, entity)
+}
@@ -0,0 +1,191 @@
+package seeker
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+//fakeCC answers GET /v2/<kind>/<guid> with the response given for that path,
+// and with a CF-NotFound error for any other path
+func fakeCC(responses map[string]func(w http.ResponseWriter, r *http.Request)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if respond, found := responses[r.URL.Path]; found {
+			respond(w, r)
+			return
+		}
+		w.WriteHeader(404)
+		w.Write([]byte(`{"code":10000,"description":"Not found","error_code":"CF-NotFound"}`))
+	}
+}
+
+func respondWith(status int, body string) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}
+}
+
+func TestResolveGUID(t *testing.T) {
+	for _, test := range []struct {
+		name      string
+		responses map[string]func(w http.ResponseWriter, r *http.Request)
+		wantType  string
+		wantName  string
+		//failures is how many lookups should be reported in an UpstreamError
+		failures int
+	}{
+		{
+			name: "space",
+			responses: map[string]func(w http.ResponseWriter, r *http.Request){
+				"/v2/spaces/guid": respondWith(200, `{"entity": {"name": "dev", "organization_guid": "org-guid"}}`),
+			},
+			wantType: ResourceTypeSpace,
+			wantName: "dev",
+		},
+		{
+			name: "stack, with other lookups forbidden",
+			responses: map[string]func(w http.ResponseWriter, r *http.Request){
+				"/v2/stacks/guid":          respondWith(200, `{"entity": {"name": "cflinuxfs3"}}`),
+				"/v2/security_groups/guid": respondWith(403, `{"code":10003,"description":"You are not authorized","error_code":"CF-NotAuthorized"}`),
+				"/v2/service_keys/guid":    respondWith(403, "Forbidden"),
+			},
+			wantType: ResourceTypeStack,
+			wantName: "cflinuxfs3",
+		},
+		{
+			name:      "missing",
+			responses: map[string]func(w http.ResponseWriter, r *http.Request){},
+		},
+		{
+			name: "forbidden",
+			responses: map[string]func(w http.ResponseWriter, r *http.Request){
+				"/v2/buildpacks/guid": respondWith(403, `{"code":10003,"description":"You are not authorized","error_code":"CF-NotAuthorized"}`),
+			},
+			failures: 1,
+		},
+		{
+			name: "upstream failure",
+			responses: map[string]func(w http.ResponseWriter, r *http.Request){
+				"/v2/buildpacks/guid": respondWith(500, `{"code":10001,"description":"Server error","error_code":"CF-ServerError"}`),
+				"/v2/stacks/guid":     respondWith(502, "Bad Gateway"),
+			},
+			failures: 2,
+		},
+	} {
+		s := newTestSeeker(t)
+		srv := useFakeCF(s, fakeCC(test.responses))
+		resource, found, err := s.ResolveGUID("guid")
+		srv.Close()
+
+		if test.failures > 0 {
+			upstreamErr, isUpstream := err.(UpstreamError)
+			if !isUpstream || len(upstreamErr.Failures) != test.failures {
+				t.Errorf("%s: Expected an UpstreamError with %d failures, got %v", test.name, test.failures, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: Expected no error, got %s", test.name, err)
+			continue
+		}
+		if test.wantType == "" {
+			if found {
+				t.Errorf("%s: Expected the GUID not to be found, got %+v", test.name, resource)
+			}
+			continue
+		}
+		if !found || resource.Type != test.wantType || resource.Name != test.wantName {
+			t.Errorf("%s: Expected %s `%s`, got %+v", test.name, test.wantType, test.wantName, resource)
+		}
+	}
+}
+
+func TestResolveGUIDCancelsOtherLookups(t *testing.T) {
+	var waiting int
+	var lock sync.Mutex
+	done := make(chan struct{})
+	defer close(done)
+
+	s := newTestSeeker(t)
+	//Let every lookup be made at once, so that the app lookup isn't stuck behind
+	// ones that only end when cancelled
+	s.guidLookups = make(chan struct{}, len(guidKinds))
+	srv := useFakeCF(s, func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/v2/apps/") {
+			w.Write([]byte(`{"entity": {"name": "my-app", "space_guid": "space-guid"}}`))
+			return
+		}
+		lock.Lock()
+		waiting++
+		lock.Unlock()
+		select {
+		case <-r.Context().Done():
+		case <-done:
+		}
+		lock.Lock()
+		waiting--
+		lock.Unlock()
+	})
+	defer srv.Close()
+
+	resource, found, err := s.ResolveGUID("guid")
+	if err != nil || !found || resource.Type != ResourceTypeApp || resource.SpaceGUID != "space-guid" {
+		t.Fatalf("Expected app with space, got %+v, %t, %v", resource, found, err)
+	}
+
+	var got int
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		lock.Lock()
+		got = waiting
+		lock.Unlock()
+		if got == 0 {
+			return
+		}
+	}
+	t.Errorf("Expected the other lookups to be cancelled, but %d are still waiting", got)
+}
+
+func TestResolveGUIDLimitsLookups(t *testing.T) {
+	var inFlight, most int
+	var lock sync.Mutex
+
+	s := newTestSeeker(t)
+	s.guidLookups = make(chan struct{}, 3)
+	srv := useFakeCF(s, func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		inFlight++
+		if inFlight > most {
+			most = inFlight
+		}
+		lock.Unlock()
+
+		time.Sleep(time.Millisecond)
+		fakeCC(nil)(w, r)
+
+		lock.Lock()
+		inFlight--
+		lock.Unlock()
+	})
+	defer srv.Close()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(guid string) {
+			defer wg.Done()
+			_, found, err := s.ResolveGUID(guid)
+			if found || err != nil {
+				t.Errorf("Expected GUID %s not to be found, got %t, %v", guid, found, err)
+			}
+		}(fmt.Sprintf("guid-%d", i))
+	}
+	wg.Wait()
+
+	if most > 3 {
+		t.Errorf("Expected at most 3 lookups at once across every GUID, but there were %d", most)
+	}
+}
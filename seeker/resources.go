@@ -0,0 +1,168 @@
+package seeker
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/starkandwayne/goutils/log"
+)
+
+//The types of CF resource that can be looked up by GUID
+const (
+	ResourceTypeApp             = "app"
+	ResourceTypeSpace           = "space"
+	ResourceTypeOrg             = "org"
+	ResourceTypeRoute           = "route"
+	ResourceTypeDomain          = "domain"
+	ResourceTypeServiceInstance = "service_instance"
+	ResourceTypeServiceBinding  = "service_binding"
+	ResourceTypeServiceKey      = "service_key"
+	ResourceTypeSecurityGroup   = "security_group"
+	ResourceTypeStack           = "stack"
+	ResourceTypeBuildpack       = "buildpack"
+)
+
+//Resource is a CF resource along with the GUIDs of what it belongs to
+type Resource struct {
+	Type string
+	GUID string
+	//Name is how the resource is known to people. For routes, this is the URL
+	// of the route.
+	Name string
+	//SpaceGUID is the space that the resource belongs to, or the space of the
+	// app or service instance it belongs to. Empty if it doesn't belong to one.
+	SpaceGUID string
+	//OrgGUID is only filled in for spaces, and for resources which belong to an
+	// org but not to a space, such as private domains
+	OrgGUID string
+	//AppGUID and AppName are only filled in for service bindings
+	AppGUID string
+	AppName string
+	//ServiceInstanceGUID and ServiceInstanceName are only filled in for service
+	// bindings and keys
+	ServiceInstanceGUID string
+	ServiceInstanceName string
+}
+
+//v2Entity holds the fields used from the entities of each type of resource
+type v2Entity struct {
+	Name                string `json:"name"`
+	Host                string `json:"host"`
+	Path                string `json:"path"`
+	Port                *int   `json:"port"`
+	DomainGUID          string `json:"domain_guid"`
+	SpaceGUID           string `json:"space_guid"`
+	OwningOrgGUID       string `json:"owning_organization_guid"`
+	OrgGUID             string `json:"organization_guid"`
+	AppGUID             string `json:"app_guid"`
+	ServiceInstanceGUID string `json:"service_instance_guid"`
+}
+
+//resourceKind is somewhere in the v2 API that resources of a type are found
+type resourceKind struct {
+	Type string
+	Path string
+}
+
+//resourceKinds are where each type of resource other than apps, spaces, and
+// orgs is found. Domains and service instances each have two kinds.
+var resourceKinds = []resourceKind{
+	{ResourceTypeRoute, "/v2/routes/"},
+	{ResourceTypeDomain, "/v2/private_domains/"},
+	{ResourceTypeDomain, "/v2/shared_domains/"},
+	{ResourceTypeServiceInstance, "/v2/service_instances/"},
+	{ResourceTypeServiceInstance, "/v2/user_provided_service_instances/"},
+	{ResourceTypeServiceBinding, "/v2/service_bindings/"},
+	{ResourceTypeServiceKey, "/v2/service_keys/"},
+	{ResourceTypeSecurityGroup, "/v2/security_groups/"},
+	{ResourceTypeStack, "/v2/stacks/"},
+	{ResourceTypeBuildpack, "/v2/buildpacks/"},
+}
+
+//resourceFromEntity makes a Resource of the given type out of its v2 entity,
+// following it to what it belongs to where needed
+func (s *Seeker) resourceFromEntity(resourceType, guid string, entity v2Entity) (ret *Resource, err error) {
+	ret = &Resource{
+		Type:      resourceType,
+		GUID:      guid,
+		Name:      entity.Name,
+		SpaceGUID: entity.SpaceGUID,
+		OrgGUID:   entity.OwningOrgGUID,
+	}
+
+	switch resourceType {
+	case ResourceTypeRoute:
+		ret.Name, err = s.routeURL(entity)
+
+	case ResourceTypeServiceBinding:
+		ret.AppGUID = entity.AppGUID
+		log.Debugf("Getting app with GUID %s from CF API", entity.AppGUID)
+		app, appErr := s.CF.GetAppByGuid(entity.AppGUID)
+		if appErr != nil {
+			return nil, fmt.Errorf("Error getting app of service binding: %s", appErr.Error())
+		}
+		ret.AppName = app.Name
+		ret.SpaceGUID = app.SpaceGuid
+		fallthrough
+
+	case ResourceTypeServiceKey:
+		ret.ServiceInstanceGUID = entity.ServiceInstanceGUID
+		var instance *Resource
+		instance, err = s.findServiceInstance(entity.ServiceInstanceGUID)
+		if err != nil {
+			return nil, err
+		}
+		ret.ServiceInstanceName = instance.Name
+		if ret.SpaceGUID == "" {
+			ret.SpaceGUID = instance.SpaceGUID
+		}
+		if ret.Name == "" {
+			ret.Name = fmt.Sprintf("%s bound to %s", ret.AppName, instance.Name)
+		}
+	}
+	return
+}
+
+//findServiceInstance looks up the managed or user-provided service instance
+// with the given GUID
+func (s *Seeker) findServiceInstance(guid string) (ret *Resource, err error) {
+	for _, kind := range resourceKinds {
+		if kind.Type != ResourceTypeServiceInstance {
+			continue
+		}
+		log.Debugf("Getting service instance with GUID %s from CF API", guid)
+		var resp struct {
+			Entity v2Entity `json:"entity"`
+		}
+		err = s.cfGet(kind.Path+guid, &resp)
+		if err == nil {
+			return &Resource{Type: kind.Type, GUID: guid, Name: resp.Entity.Name, SpaceGUID: resp.Entity.SpaceGUID}, nil
+		}
+		if !isNotFound(err) {
+			return nil, fmt.Errorf("While looking up service instance with GUID `%s`: %s", guid, err.Error())
+		}
+	}
+	return nil, fmt.Errorf("Could not find service instance with GUID `%s`", guid)
+}
+
+//routeURL returns the URL that the route with the given entity answers to,
+// without a scheme
+func (s *Seeker) routeURL(route v2Entity) (string, error) {
+	var domain struct {
+		Entity v2Entity `json:"entity"`
+	}
+	log.Debugf("Getting domain with GUID %s from CF API", route.DomainGUID)
+	err := s.cfGet("/v2/domains/"+route.DomainGUID, &domain)
+	if err != nil {
+		return "", fmt.Errorf("Error getting domain of route: %s", err.Error())
+	}
+
+	url := domain.Entity.Name
+	if route.Host != "" {
+		url = route.Host + "." + url
+	}
+	if route.Port != nil && *route.Port != 0 {
+		url = url + ":" + strconv.Itoa(*route.Port)
+	}
+	return url + route.Path, nil
+}
@@ -0,0 +1,154 @@
+package seeker
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	cfclient "github.com/cloudfoundry-community/go-cfclient"
+	"github.com/starkandwayne/goutils/log"
+)
+
+//RouteMeta has information about a CF route that a URL was resolved to
+type RouteMeta struct {
+	GUID   string
+	Host   string
+	Domain string
+	Path   string
+}
+
+//String gives the route in the form the CF CLI shows it, such as
+// foo.apps.example.com/api
+func (r RouteMeta) String() string {
+	ret := r.Domain
+	if r.Host != "" {
+		ret = r.Host + "." + ret
+	}
+	return ret + r.Path
+}
+
+//FindAppsByRoute resolves the given URL to the CF route that the gorouter
+// would send its requests to, and returns that route along with the started
+// apps that are mapped to it. The URL may be given with or without a scheme.
+func (s *Seeker) FindAppsByRoute(rawURL string) (route *RouteMeta, apps []AppMeta, err error) {
+	route, err = s.findRoute(rawURL)
+	if err != nil {
+		return
+	}
+
+	log.Debugf("Getting apps mapped to route with GUID %s from CF API", route.GUID)
+	cfApps, err := s.CF.ListAppsByRoute(route.GUID)
+	if err != nil {
+		err = fmt.Errorf("Error when getting apps mapped to route `%s`: %s", route, err.Error())
+		return
+	}
+
+	for _, app := range cfApps {
+		if app.State != "STARTED" {
+			log.Debugf("Skipping app with GUID %s in state %s", app.Guid, app.State)
+			continue
+		}
+		apps = append(apps, AppMeta{Name: app.Name, GUID: app.Guid})
+	}
+	return
+}
+
+//findRoute tries each way of splitting the URL's hostname into a route host
+// and a domain, starting with the longest domain, until one names a domain that
+// exists and has a route with the given host. Of that host's routes, the one
+// with the longest path matching the URL's path is returned.
+func (s *Seeker) findRoute(rawURL string) (route *RouteMeta, err error) {
+	toParse := rawURL
+	if !strings.Contains(toParse, "://") {
+		toParse = "http://" + toParse
+	}
+	u, err := url.Parse(toParse)
+	if err != nil {
+		err = fmt.Errorf("Could not parse URL `%s`: %s", rawURL, err.Error())
+		return
+	}
+
+	hostname := strings.ToLower(u.Hostname())
+	if hostname == "" {
+		err = fmt.Errorf("No hostname given in URL `%s`", rawURL)
+		return
+	}
+
+	labels := strings.Split(hostname, ".")
+	for i := 0; i < len(labels)-1; i++ {
+		host := strings.Join(labels[:i], ".")
+		domain := strings.Join(labels[i:], ".")
+
+		var domainGUID string
+		domainGUID, err = s.getDomainGUID(domain)
+		if err != nil {
+			return
+		}
+		if domainGUID == "" {
+			continue
+		}
+
+		log.Debugf("Getting routes with host (%s) and domain GUID (%s) from CF API", host, domainGUID)
+		query := url.Values{}
+		query.Add("q", "host:"+host)
+		query.Add("q", "domain_guid:"+domainGUID)
+		var routes []cfclient.Route
+		routes, err = s.CF.ListRoutesByQuery(query)
+		if err != nil {
+			err = fmt.Errorf("Error when getting routes for domain `%s`: %s", domain, err.Error())
+			return
+		}
+
+		for _, r := range routes {
+			if !pathMatches(r.Path, u.Path) {
+				continue
+			}
+			if route == nil || len(r.Path) > len(route.Path) {
+				route = &RouteMeta{GUID: r.Guid, Host: r.Host, Domain: domain, Path: r.Path}
+			}
+		}
+
+		if route != nil {
+			return route, nil
+		}
+	}
+
+	return nil, fmt.Errorf("Could not find a route for URL `%s`", rawURL)
+}
+
+//getDomainGUID looks for a shared or private domain with the given name. If
+// there is no such domain, guid is empty.
+func (s *Seeker) getDomainGUID(name string) (guid string, err error) {
+	query := url.Values{}
+	query.Set("q", "name:"+name)
+
+	log.Debugf("Getting shared domain by name (%s) from CF API", name)
+	shared, err := s.CF.ListSharedDomainsByQuery(query)
+	if err != nil {
+		err = fmt.Errorf("Error when looking up domain `%s`: %s", name, err.Error())
+		return
+	}
+	if len(shared) > 0 {
+		return shared[0].Guid, nil
+	}
+
+	log.Debugf("Getting private domain by name (%s) from CF API", name)
+	private, err := s.CF.ListDomainsByQuery(query)
+	if err != nil {
+		err = fmt.Errorf("Error when looking up domain `%s`: %s", name, err.Error())
+		return
+	}
+	if len(private) > 0 {
+		return private[0].Guid, nil
+	}
+	return
+}
+
+//pathMatches returns true if a route with the given path would receive
+// requests for the given request path. Route paths match on whole path segments.
+func pathMatches(routePath, requestPath string) bool {
+	if routePath == "" {
+		return true
+	}
+	return requestPath == routePath || strings.HasPrefix(requestPath, routePath+"/")
+}
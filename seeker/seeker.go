@@ -1,6 +1,8 @@
 package seeker
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"net/http"
 	"time"
@@ -14,10 +16,20 @@ import (
 //Seeker has constructs and functions necessary to find app locations in Cloud
 // Foundry
 type Seeker struct {
-	CF      *cfclient.Client
-	bosh    *gogobosh.Client
-	config  *config.Config
-	vmcache *VMCache
+	CF        *cfclient.Client
+	bosh      map[string]*gogobosh.Client //keyed by director name
+	config    *config.Config
+	vmcache   *VMCache
+	cfcache   *CFCache
+	crawler   *crawler
+	discovery *discovery
+	backend   *cfBackend
+	names     *placementNames
+	listings  *listingCache
+
+	//guidLookups holds a slot for each GUID lookup in flight, so that only so
+	// many are made at once across every GUID being resolved
+	guidLookups chan struct{}
 }
 
 //NewSeeker returns a NewSeeker with a client configured with the information
@@ -33,19 +45,38 @@ func NewSeeker(conf *config.Config) (ret *Seeker, err error) {
 	}
 	log.Debugf("Done setting up CF Client")
 
+	ret.names = newPlacementNames()
+	ret.listings = newListingCache()
+
+	ret.backend, err = newCFBackend(conf.CF.Backend)
+	if err != nil {
+		return nil, err
+	}
+
+	ret.bosh = map[string]*gogobosh.Client{}
 	if ret.BOSHConfigured() {
-		log.Debugf("Setting up BOSH Client")
-		ret.bosh, err = ret.getBOSHClientFromConfig()
-		if err != nil {
-			return nil, fmt.Errorf("Error connecting to BOSH API: %s", err.Error())
+		for _, director := range ret.directors() {
+			log.Debugf("Setting up BOSH Client for director (%s)", director.Name)
+			ret.bosh[director.Name], err = ret.getBOSHClientFromConfig(director)
+			if err != nil {
+				return nil, fmt.Errorf("Error connecting to BOSH API of director `%s`: %s", director.Name, err.Error())
+			}
 		}
 
-		log.Debugf("Done setting up BOSH Client")
+		log.Debugf("Done setting up BOSH Clients")
 	} else {
 		log.Debugf("Skipping BOSH Client setup")
 	}
 
+	ret.discovery, err = newDiscovery(ret.directors())
+	if err != nil {
+		return nil, err
+	}
+
 	ret.vmcache = newVMCache()
+	ret.cfcache = newCFCache()
+	ret.crawler = newCrawler(conf.CF.Concurrency)
+	ret.guidLookups = make(chan struct{}, ret.Workers())
 	return
 }
 
@@ -64,26 +95,94 @@ func (s *Seeker) getCFClientFromConfig() (client *cfclient.Client, err error) {
 }
 
 // getBOSHClientFromConfig returns a gogobosh.Client object that has been
-// initialized with the settings given in the config in the receiver Seeker
-// object.
-func (s *Seeker) getBOSHClientFromConfig() (client *gogobosh.Client, err error) {
+// initialized with the settings given for the director in the config in the
+// receiver Seeker object.
+func (s *Seeker) getBOSHClientFromConfig(director config.BOSHDirectorConfig) (client *gogobosh.Client, err error) {
+	httpClient, err := s.boshHTTPClient(director)
+	if err != nil {
+		return
+	}
+
 	return gogobosh.NewClient(&gogobosh.Config{
-		BOSHAddress:       s.config.BOSH.APIAddress,
-		Username:          s.config.BOSH.Username,
-		Password:          s.config.BOSH.Password,
-		ClientID:          s.config.BOSH.ClientID,
-		ClientSecret:      s.config.BOSH.ClientSecret,
-		HttpClient:        &http.Client{Timeout: time.Second * time.Duration(s.config.HTTPTimeout)},
-		SkipSslValidation: s.config.BOSH.SkipSSLValidation,
+		BOSHAddress:       director.APIAddress,
+		Username:          director.Username,
+		Password:          director.Password,
+		ClientID:          director.ClientID,
+		ClientSecret:      director.ClientSecret,
+		HttpClient:        httpClient,
+		SkipSslValidation: director.SkipSSLValidation,
 	})
 }
 
+//boshHTTPClient returns the HTTP client to give gogobosh for the director,
+// trusting the director's CA certificate if one is configured
+func (s *Seeker) boshHTTPClient(director config.BOSHDirectorConfig) (*http.Client, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: director.SkipSSLValidation}
+	if director.CACert != "" {
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM([]byte(director.CACert)) {
+			return nil, fmt.Errorf("Could not parse the CA certificate of director `%s`", director.Name)
+		}
+	}
+
+	return &http.Client{
+		Timeout:   time.Second * time.Duration(s.config.HTTPTimeout),
+		Transport: &http.Transport{TLSClientConfig: tlsConfig},
+	}, nil
+}
+
 // BOSHConfigured returns true if the attached configuration has all the keys
-// required to attempt a connection to BOSH. False otherwise.
+// required to attempt a connection to at least one BOSH director. False
+// otherwise.
 func (s *Seeker) BOSHConfigured() bool {
-	conf := s.config.BOSH
-	return conf.APIAddress != "" &&
-		len(conf.Deployments) > 0 &&
-		((conf.Username != "" && conf.Password != "") || (conf.ClientID != "" && conf.ClientSecret != "")) &&
-		!conf.SkipBOSH
+	return len(s.directors()) > 0 && !s.config.BOSH.SkipBOSH
+}
+
+//directors returns the configured BOSH directors which have enough config to
+// be connected to
+func (s *Seeker) directors() (ret []config.BOSHDirectorConfig) {
+	for _, director := range s.config.BOSH.Directors {
+		if director.Configured() {
+			ret = append(ret, director)
+		} else {
+			log.Debugf("Skipping BOSH director (%s) which is not fully configured", director.Name)
+		}
+	}
+	return
+}
+
+//boshDeployment is a deployment on a particular BOSH director
+type boshDeployment struct {
+	Director string
+	Name     string
+}
+
+func (d boshDeployment) String() string {
+	return d.Director + "/" + d.Name
+}
+
+//boshDeployments returns every deployment configured or discovered for each
+// usable director, in the order they are configured
+func (s *Seeker) boshDeployments() (ret []boshDeployment, err error) {
+	if !s.BOSHConfigured() {
+		return
+	}
+	for _, director := range s.directors() {
+		var discovered []string
+		discovered, err = s.discoveredDeployments(director)
+		if err != nil {
+			return nil, err
+		}
+
+		seen := map[string]bool{}
+		for _, names := range [][]string{director.Deployments, discovered} {
+			for _, name := range names {
+				if !seen[name] {
+					seen[name] = true
+					ret = append(ret, boshDeployment{Director: director.Name, Name: name})
+				}
+			}
+		}
+	}
+	return
 }
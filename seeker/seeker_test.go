@@ -0,0 +1,33 @@
+package seeker
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cloudfoundry-community/gogobosh"
+)
+
+func TestDirectorCACert(t *testing.T) {
+	director := newFakeDirector(map[string][]gogobosh.VM{"cf": {testVM("router", 0, "10.0.0.1")}})
+	director.Close()
+	director.Server = httptest.NewTLSServer(http.HandlerFunc(director.serve))
+	defer director.Close()
+
+	caCert := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: director.TLS.Certificates[0].Certificate[0]}))
+
+	trusted := director.directorConfig("main", "cf")
+	trusted.CACert = caCert
+	s := newTestSeeker(t, trusted)
+	vm, err := s.GetVMWithIP("10.0.0.1")
+	if err != nil || vm == nil {
+		t.Errorf("Expected the director to be trusted with its CA certificate, got %+v, %v", vm, err)
+	}
+
+	untrusted := &Seeker{config: s.config}
+	_, err = untrusted.getBOSHClientFromConfig(director.directorConfig("main", "cf"))
+	if err == nil {
+		t.Errorf("Expected the director not to be trusted without its CA certificate")
+	}
+}
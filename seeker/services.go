@@ -0,0 +1,102 @@
+package seeker
+
+import (
+	"fmt"
+	"net/url"
+
+	cfclient "github.com/cloudfoundry-community/go-cfclient"
+	"github.com/starkandwayne/goutils/log"
+)
+
+//onDemandDeploymentPrefix begins the name of each BOSH deployment made by an
+// on-demand service broker. It is followed by the GUID of the service instance.
+const onDemandDeploymentPrefix = "service-instance_"
+
+//ServiceInstanceMeta has information from the CF API about a service instance
+type ServiceInstanceMeta struct {
+	Name string
+	GUID string
+	//Type is managed_service_instance or user_provided_service_instance
+	Type string
+	//LastOperation is the state of the last operation the broker performed on the
+	// service instance, such as "create succeeded"
+	LastOperation string
+}
+
+//ServiceDeployment returns the name of the BOSH deployment that an on-demand
+// service broker makes for the service instance with the given GUID
+func ServiceDeployment(guid string) string {
+	return onDemandDeploymentPrefix + guid
+}
+
+//ServiceInstanceByGUID looks up the service instance with the given GUID
+func (s *Seeker) ServiceInstanceByGUID(guid string) (ret *ServiceInstanceMeta, err error) {
+	log.Debugf("Getting service instance with GUID %s from CF API", guid)
+	instance, err := s.CF.GetServiceInstanceByGuid(guid)
+	if err != nil {
+		err = fmt.Errorf("While looking up service instance with GUID `%s`: %s", guid, err.Error())
+		return
+	}
+	return serviceInstanceMetaFromCF(instance), nil
+}
+
+//ServiceInstanceByName looks up the service instance with the given name in the
+// given org and space
+func (s *Seeker) ServiceInstanceByName(orgname, spacename, name string) (ret *ServiceInstanceMeta, err error) {
+	orgGUID, _, err := s.OrgByName(orgname)
+	if err != nil {
+		err = lookupError("org", err)
+		return
+	}
+
+	spaceGUID, _, err := s.SpaceByName(orgGUID, spacename)
+	if err != nil {
+		err = lookupError("space", err)
+		return
+	}
+
+	query := url.Values{}
+	query.Add("q", "name:"+name)
+	query.Add("q", "space_guid:"+spaceGUID)
+	log.Debugf("Getting service instance by name (%s) and space GUID (%s) from CF API", name, spaceGUID)
+	instances, err := s.CF.ListServiceInstancesByQuery(query)
+	if err != nil {
+		err = lookupError("service instance", err)
+		return
+	}
+	if len(instances) == 0 {
+		err = NameNotFoundError{Kind: "service instance", Name: name}
+		return
+	}
+	return serviceInstanceMetaFromCF(instances[0]), nil
+}
+
+func serviceInstanceMetaFromCF(instance cfclient.ServiceInstance) *ServiceInstanceMeta {
+	ret := &ServiceInstanceMeta{
+		Name: instance.Name,
+		GUID: instance.Guid,
+		Type: instance.Type,
+	}
+	if instance.LastOperation.Type != "" {
+		ret.LastOperation = instance.LastOperation.Type + " " + instance.LastOperation.State
+	}
+	return ret
+}
+
+//BoundAppGUIDs returns the GUIDs of the apps bound to the service instance with
+// the given GUID
+func (s *Seeker) BoundAppGUIDs(serviceGUID string) (guids []string, err error) {
+	query := url.Values{}
+	query.Set("q", "service_instance_guid:"+serviceGUID)
+	log.Debugf("Getting service bindings of service instance with GUID %s from CF API", serviceGUID)
+	bindings, err := s.CF.ListServiceBindingsByQuery(query)
+	if err != nil {
+		err = fmt.Errorf("While listing bindings of service instance with GUID `%s`: %s", serviceGUID, err.Error())
+		return
+	}
+
+	for _, binding := range bindings {
+		guids = append(guids, binding.AppGuid)
+	}
+	return
+}
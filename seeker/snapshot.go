@@ -0,0 +1,86 @@
+package seeker
+
+import (
+	"time"
+
+	"github.com/starkandwayne/goutils/log"
+)
+
+//VMCacheSnapshot is the contents of the BOSH VM cache in a form that can be
+// saved, so that the cache can be filled from it again after a restart
+type VMCacheSnapshot struct {
+	Deployments []DeploymentSnapshot `json:"deployments"`
+}
+
+//DeploymentSnapshot is the cached VMs of one BOSH deployment, and when they were
+// fetched from its director
+type DeploymentSnapshot struct {
+	Director string    `json:"director"`
+	Name     string    `json:"name"`
+	CachedAt time.Time `json:"cached_at"`
+	//VMs has one entry for each IP address of each VM, as the cache does
+	VMs []VMInfo `json:"vms"`
+}
+
+//SnapshotVMCache returns everything in the BOSH VM cache, including entries
+// which have gone stale
+func (s *Seeker) SnapshotVMCache() (ret VMCacheSnapshot) {
+	s.acquireLock()
+	defer s.releaseLock()
+	c := s.vmcache
+	ret.Deployments = []DeploymentSnapshot{}
+	for dep, entry := range c.deployments {
+		snap := DeploymentSnapshot{
+			Director: dep.Director,
+			Name:     dep.Name,
+			CachedAt: entry.cachedAt,
+			VMs:      make([]VMInfo, 0, len(entry.hosts)),
+		}
+		for _, host := range entry.hosts {
+			if vm := c.data[vmKey{Director: dep.Director, IP: host}]; vm != nil {
+				snap.VMs = append(snap.VMs, *vm)
+			}
+		}
+		ret.Deployments = append(ret.Deployments, snap)
+	}
+	return
+}
+
+//LoadVMCacheSnapshot fills the BOSH VM cache from the given snapshot, keeping
+// the times the deployments were originally cached at. Deployments which have
+// outlived the TTL, or whose director is no longer configured, are discarded.
+// Deployments which are already cached are left as they are. The TTL should be
+// set before this is called.
+func (s *Seeker) LoadVMCacheSnapshot(snap VMCacheSnapshot) (loaded, discarded int) {
+	s.acquireLock()
+	defer s.releaseLock()
+	c := s.vmcache
+	for _, d := range snap.Deployments {
+		dep := boshDeployment{Director: d.Director, Name: d.Name}
+		if age := time.Since(d.CachedAt); c.ttl >= 0 && age >= c.ttl {
+			log.Debugf("Discarding saved deployment (%s). Age: %s, TTL: %s", dep, age, c.ttl)
+			discarded++
+			continue
+		}
+		if _, configured := s.bosh[dep.Director]; !configured {
+			log.Debugf("Discarding saved deployment (%s) from unconfigured director", dep)
+			discarded++
+			continue
+		}
+		if c.deployments[dep] != nil {
+			continue
+		}
+
+		hosts := make([]string, 0, len(d.VMs))
+		for i := range d.VMs {
+			vm := d.VMs[i]
+			vm.Director, vm.DeploymentName = dep.Director, dep.Name
+			c.data[vm.key()] = &vm
+			hosts = append(hosts, vm.IP)
+		}
+		c.deployments[dep] = &deploymentEntry{hosts: hosts, cachedAt: d.CachedAt}
+		loaded++
+	}
+	log.Debugf("Loaded %d deployments into the BOSH VM cache, and discarded %d", loaded, discarded)
+	return
+}
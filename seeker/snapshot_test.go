@@ -0,0 +1,80 @@
+package seeker
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/cloudfoundry-community/gogobosh"
+)
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	director := newFakeDirector(map[string][]gogobosh.VM{
+		"cf": {testVM("diego_cell", 0, "10.0.0.1", "10.0.1.1"), testVM("router", 0, "10.0.0.2")},
+	})
+	defer director.Close()
+
+	saved := newTestSeeker(t, director.directorConfig("main", "cf"))
+	saved.SetTTL(time.Hour)
+	err := saved.cacheAll()
+	if err != nil {
+		t.Fatalf("Could not cache deployments: %s", err)
+	}
+
+	contents, err := json.Marshal(saved.SnapshotVMCache())
+	if err != nil {
+		t.Fatalf("Could not marshal snapshot: %s", err)
+	}
+	var snap VMCacheSnapshot
+	err = json.Unmarshal(contents, &snap)
+	if err != nil {
+		t.Fatalf("Could not unmarshal snapshot: %s", err)
+	}
+
+	loaded := newTestSeeker(t, director.directorConfig("main", "cf"))
+	loaded.SetTTL(time.Hour)
+	if n, discarded := loaded.LoadVMCacheSnapshot(snap); n != 1 || discarded != 0 {
+		t.Fatalf("Expected 1 deployment loaded and none discarded, got %d and %d", n, discarded)
+	}
+
+	for _, ip := range []string{"10.0.0.1", "10.0.1.1", "10.0.0.2"} {
+		vm, err := loaded.GetVMWithIP(ip)
+		if err != nil || vm == nil {
+			t.Errorf("Expected VM with IP %s to be loaded, got %+v, %v", ip, vm, err)
+			continue
+		}
+		if vm.Director != "main" || vm.DeploymentName != "cf" {
+			t.Errorf("Expected VM with IP %s to be in deployment cf of director main, got %+v", ip, vm)
+		}
+	}
+	if listings := director.listings("cf"); listings != 1 {
+		t.Errorf("Expected loaded VMs to be used without listing them again, but they were listed %d times", listings)
+	}
+}
+
+func TestSnapshotDiscardsStaleAndUnknownDeployments(t *testing.T) {
+	director := newFakeDirector(map[string][]gogobosh.VM{"cf": nil})
+	defer director.Close()
+
+	vms := []VMInfo{{JobName: "diego_cell", IP: "10.0.0.1"}}
+	snap := VMCacheSnapshot{Deployments: []DeploymentSnapshot{
+		{Director: "main", Name: "fresh", CachedAt: time.Now().Add(-time.Minute), VMs: vms},
+		{Director: "main", Name: "stale", CachedAt: time.Now().Add(-2 * time.Hour), VMs: vms},
+		{Director: "gone", Name: "fresh", CachedAt: time.Now(), VMs: vms},
+	}}
+
+	s := newTestSeeker(t, director.directorConfig("main", "cf"))
+	s.SetTTL(time.Hour)
+	loaded, discarded := s.LoadVMCacheSnapshot(snap)
+	if loaded != 1 || discarded != 2 {
+		t.Errorf("Expected 1 deployment loaded and 2 discarded, got %d and %d", loaded, discarded)
+	}
+	for dep := range s.vmcache.deployments {
+		if dep != (boshDeployment{Director: "main", Name: "fresh"}) {
+			t.Errorf("Expected only the fresh deployment of a configured director to be loaded, got %s", dep)
+		}
+	}
+	if vm := s.vmcache.data[vmKey{Director: "main", IP: "10.0.0.1"}]; vm == nil || vm.DeploymentName != "fresh" {
+		t.Errorf("Expected VM from the fresh deployment to be cached, got %+v", vm)
+	}
+}
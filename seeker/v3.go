@@ -0,0 +1,363 @@
+package seeker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	cfclient "github.com/cloudfoundry-community/go-cfclient"
+	"github.com/pkg/errors"
+	"github.com/starkandwayne/goutils/log"
+)
+
+//The versions of the CF API that app instances can be looked up with
+const (
+	BackendV2 = "v2"
+	BackendV3 = "v3"
+)
+
+//cfBackend remembers which version of the CF API to look up app instances with
+type cfBackend struct {
+	wantV3 bool
+	//checked is true once it is known whether the CF API supports v3
+	checked     bool
+	v3Available bool
+	lock        sync.Mutex
+}
+
+func newCFBackend(name string) (*cfBackend, error) {
+	switch name {
+	case "", BackendV2:
+		return &cfBackend{}, nil
+	case BackendV3:
+		return &cfBackend{wantV3: true}, nil
+	}
+	return nil, fmt.Errorf("Unknown CF backend `%s`. Must be `%s` or `%s`", name, BackendV2, BackendV3)
+}
+
+//useV3 returns true if app instances should be looked up with the v3 API. The
+// first time it is called with the v3 backend configured, the CF API is asked
+// whether it supports v3, and the answer is kept from then on. Anything other
+// than a successful response, including a 404 or the API not being reachable,
+// means the v2 API is used instead.
+func (s *Seeker) useV3() bool {
+	b := s.backend
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if !b.wantV3 || b.checked {
+		return b.v3Available
+	}
+
+	log.Debugf("Checking if the CF API supports v3")
+	resp, err := s.cfRequest(context.Background(), "/v3")
+	switch {
+	case err != nil:
+		log.Errorf("Could not check if the CF API supports v3. Falling back to v2: %s", err.Error())
+	case resp.StatusCode == http.StatusNotFound:
+		log.Infof("The CF API does not support v3. Falling back to v2")
+	case resp.StatusCode != http.StatusOK:
+		log.Errorf("Unexpected status %d when checking if the CF API supports v3. Falling back to v2", resp.StatusCode)
+	default:
+		b.v3Available = true
+	}
+	if resp != nil {
+		resp.Body.Close()
+	}
+	b.checked = true
+	return b.v3Available
+}
+
+type v3Pagination struct {
+	Next *struct {
+		Href string `json:"href"`
+	} `json:"next"`
+}
+
+type v3App struct {
+	GUID string `json:"guid"`
+	Name string `json:"name"`
+}
+
+type v3Process struct {
+	GUID      string `json:"guid"`
+	Type      string `json:"type"`
+	Instances int    `json:"instances"`
+}
+
+type v3Sidecar struct {
+	Name string `json:"name"`
+}
+
+//v3Errors is the body of an error response from the v3 API
+type v3Errors struct {
+	Errors []struct {
+		Code   int    `json:"code"`
+		Title  string `json:"title"`
+		Detail string `json:"detail"`
+	} `json:"errors"`
+}
+
+type v3ProcessStats struct {
+	Type       string `json:"type"`
+	Index      int    `json:"index"`
+	State      string `json:"state"`
+	Host       string `json:"host"`
+	InternalIP string `json:"instance_internal_ip"`
+	Uptime     int    `json:"uptime"`
+	MemQuota   int    `json:"mem_quota"`
+	DiskQuota  int    `json:"disk_quota"`
+	FdsQuota   int    `json:"fds_quota"`
+	Usage      struct {
+		Time string  `json:"time"`
+		CPU  float64 `json:"cpu"`
+		Mem  int     `json:"mem"`
+		Disk int     `json:"disk"`
+	} `json:"usage"`
+	InstancePorts []struct {
+		External int `json:"external"`
+		Internal int `json:"internal"`
+	} `json:"instance_ports"`
+}
+
+//cfGet makes a GET request to the given path of the CF API and decodes the JSON
+// response into out
+func (s *Seeker) cfGet(path string, out interface{}) error {
+	return s.cfGetContext(context.Background(), path, out)
+}
+
+//cfGetContext works like cfGet, except that the request is abandoned when the
+// given context is done. Errors the CF API responds with are returned as
+// cfclient.CloudFoundryErrors, as the CF client does. Since v3 errors come in a
+// list, only the first of them is returned.
+func (s *Seeker) cfGetContext(ctx context.Context, path string, out interface{}) error {
+	resp, err := s.cfRequest(ctx, path)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return cfStatusError{Status: resp.StatusCode, Path: path}
+		}
+		var v3Err v3Errors
+		if json.Unmarshal(body, &v3Err) == nil && len(v3Err.Errors) > 0 {
+			first := v3Err.Errors[0]
+			return cfclient.CloudFoundryError{Code: first.Code, ErrorCode: first.Title, Description: first.Detail}
+		}
+		var cfErr cfclient.CloudFoundryError
+		if json.Unmarshal(body, &cfErr) == nil && cfErr.ErrorCode != "" {
+			return cfErr
+		}
+		return cfStatusError{Status: resp.StatusCode, Path: path}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return cfStatusError{Status: resp.StatusCode, Path: path}
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+//cfStatusError is returned by cfGetContext when the CF API responds with a
+// status it doesn't explain with an error body
+type cfStatusError struct {
+	Status int
+	Path   string
+}
+
+func (e cfStatusError) Error() string {
+	return fmt.Sprintf("Unexpected status %d from %s", e.Status, e.Path)
+}
+
+//cfRequest makes a GET request to the given path of the CF API with the CF
+// client's credentials. The caller must close the body of the response.
+func (s *Seeker) cfRequest(ctx context.Context, path string) (*http.Response, error) {
+	req, err := http.NewRequest("GET", s.CF.Config.ApiAddress+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("User-Agent", s.CF.Config.UserAgent)
+	return s.CF.Config.HttpClient.Do(req)
+}
+
+//v3List follows the pages of a v3 list response, starting at the given path.
+// The resources of each page are decoded into the slice that page points to,
+// replacing those of the page before, and then onPage is called.
+func (s *Seeker) v3List(path string, page interface{}, onPage func()) error {
+	for path != "" {
+		resp := struct {
+			Pagination v3Pagination `json:"pagination"`
+			Resources  interface{}  `json:"resources"`
+		}{Resources: page}
+		err := s.cfGet(path, &resp)
+		if err != nil {
+			return err
+		}
+		onPage()
+
+		path = ""
+		if next := resp.Pagination.Next; next != nil && next.Href != "" {
+			//The next page is given as an absolute URL, which needn't use the same
+			// scheme or host as the configured API address
+			u, err := url.Parse(next.Href)
+			if err != nil {
+				return fmt.Errorf("Could not parse URL of next page `%s`: %s", next.Href, err.Error())
+			}
+			path = u.RequestURI()
+		}
+	}
+	return nil
+}
+
+//findInstancesV3 looks up the instances of every process of the app with the
+// given GUID with the v3 API
+func (s *Seeker) findInstancesV3(guid string) (meta *AppMeta, inst []AppInstance, err error) {
+	log.Debugf("Getting app with GUID %s from CF v3 API", guid)
+	var app v3App
+	err = s.cfGet("/v3/apps/"+guid, &app)
+	if err != nil {
+		err = errors.Wrapf(err, "Error when getting app with GUID `%s`", guid)
+		return
+	}
+
+	meta = &AppMeta{Name: app.Name, GUID: guid}
+	meta.Processes, inst, err = s.v3Instances(guid)
+	return
+}
+
+//v3Instances gets the processes of the app with the given GUID, and the stats
+// of the instances of each of them
+func (s *Seeker) v3Instances(guid string) (procs []ProcessMeta, inst []AppInstance, err error) {
+	log.Debugf("Getting processes of app with GUID %s from CF v3 API", guid)
+	var processes []v3Process
+	var page []v3Process
+	err = s.v3List("/v3/apps/"+guid+"/processes", &page, func() {
+		processes = append(processes, page...)
+	})
+	if err != nil {
+		err = fmt.Errorf("Error when getting processes for app with GUID `%s`: %s", guid, err.Error())
+		return
+	}
+	sort.Sort(v3ProcessesByType(processes))
+
+	for _, process := range processes {
+		log.Debugf("Getting stats for process with GUID %s from CF v3 API", process.GUID)
+		var stats struct {
+			Resources []v3ProcessStats `json:"resources"`
+		}
+		err = s.cfGet("/v3/processes/"+process.GUID+"/stats", &stats)
+		if err != nil {
+			err = fmt.Errorf("Error when getting stats for process `%s` of app with GUID `%s`: %s", process.Type, guid, err.Error())
+			return
+		}
+
+		for _, stat := range stats.Resources {
+			var instance AppInstance
+			instance, err = appInstanceFromV3Stats(process.Type, stat)
+			if err != nil {
+				return
+			}
+			inst = append(inst, instance)
+		}
+
+		procs = append(procs, ProcessMeta{
+			Type:      process.Type,
+			Instances: process.Instances,
+			Sidecars:  s.v3Sidecars(process.GUID),
+		})
+	}
+	return
+}
+
+//v3Sidecars returns the names of the sidecars of the process with the given
+// GUID. Sidecars aren't supported by every CF API that supports v3, so none are
+// returned if they can't be listed.
+func (s *Seeker) v3Sidecars(processGUID string) (ret []string) {
+	var page []v3Sidecar
+	err := s.v3List("/v3/processes/"+processGUID+"/sidecars", &page, func() {
+		for _, sidecar := range page {
+			ret = append(ret, sidecar.Name)
+		}
+	})
+	if err != nil {
+		log.Debugf("Could not list sidecars of process with GUID %s: %s", processGUID, err.Error())
+		return nil
+	}
+	return
+}
+
+//appInstanceFromV3Stats makes an AppInstance out of the stats of one instance
+// of a process of the given type. If the time its usage was reported at can't
+// be read, the instance is returned without usage.
+func appInstanceFromV3Stats(processType string, stats v3ProcessStats) (ret AppInstance, err error) {
+	ret.Index = stats.Index
+	ret.State = stats.State
+	ret.ProcessType = processType
+	if !ret.Running() {
+		return
+	}
+
+	ret.Host, err = canonizeIP(stats.Host)
+	if err != nil {
+		return
+	}
+	if stats.InternalIP != "" {
+		ret.InternalIP, err = canonizeIP(stats.InternalIP)
+		if err != nil {
+			return
+		}
+	}
+	for _, port := range stats.InstancePorts {
+		ret.Ports = append(ret.Ports, PortMapping{External: port.External, Internal: port.Internal})
+	}
+	//The first port is the one that routes send traffic to
+	ret.Port = UnknownPort
+	if len(ret.Ports) > 0 {
+		ret.Port = ret.Ports[0].External
+	}
+
+	ret.Usage = &InstanceUsage{
+		CPU:       stats.Usage.CPU,
+		Mem:       stats.Usage.Mem,
+		MemQuota:  stats.MemQuota,
+		Disk:      stats.Usage.Disk,
+		DiskQuota: stats.DiskQuota,
+		FdsQuota:  stats.FdsQuota,
+		Uptime:    time.Duration(stats.Uptime) * time.Second,
+	}
+	if stats.Usage.Time != "" {
+		reportedAt, timeErr := time.Parse(time.RFC3339Nano, stats.Usage.Time)
+		if timeErr != nil {
+			log.Warnf("Dropping usage of instance %d of process `%s`. Could not parse usage time `%s`: %s", stats.Index, processType, stats.Usage.Time, timeErr.Error())
+			ret.Usage = nil
+			return
+		}
+		ret.Usage.ReportedAt = reportedAt
+	}
+	return
+}
+
+//v3ProcessesByType puts the web process first, followed by the rest in order
+// of their type
+type v3ProcessesByType []v3Process
+
+func (p v3ProcessesByType) Len() int      { return len(p) }
+func (p v3ProcessesByType) Swap(i, j int) { p[i], p[j] = p[j], p[i] }
+func (p v3ProcessesByType) Less(i, j int) bool {
+	return ProcessTypeLess(p[i].Type, p[j].Type)
+}
+
+//ProcessTypeLess orders process types with web first, followed by the rest in
+// alphabetical order
+func ProcessTypeLess(a, b string) bool {
+	if (a == "web") != (b == "web") {
+		return a == "web"
+	}
+	return a < b
+}
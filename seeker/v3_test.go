@@ -0,0 +1,129 @@
+package seeker
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestUseV3(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		status int
+		body   string
+		closed bool
+		want   bool
+	}{
+		{name: "supported", status: 200, body: `{"links":{}}`, want: true},
+		{name: "CF 404", status: 404, body: `{"code":10000,"description":"Unknown request","error_code":"CF-NotFound"}`},
+		{name: "non-CF 404", status: 404, body: "404 page not found"},
+		{name: "server error", status: 502, body: "Bad Gateway"},
+		{name: "unreachable", closed: true},
+	} {
+		var checks int
+		var lock sync.Mutex
+		s := newTestSeeker(t)
+		s.backend.wantV3 = true
+		srv := useFakeCF(s, func(w http.ResponseWriter, r *http.Request) {
+			lock.Lock()
+			checks++
+			lock.Unlock()
+			w.WriteHeader(test.status)
+			w.Write([]byte(test.body))
+		})
+		if test.closed {
+			srv.Close()
+		}
+
+		for i := 0; i < 2; i++ {
+			if got := s.useV3(); got != test.want {
+				t.Errorf("%s: Expected useV3 to be %t, got %t", test.name, test.want, got)
+			}
+		}
+		if !s.backend.checked {
+			t.Errorf("%s: Expected the outcome of the check to be recorded", test.name)
+		}
+		if !test.closed && checks != 1 {
+			t.Errorf("%s: Expected the CF API to be checked once, but it was checked %d times", test.name, checks)
+		}
+		srv.Close()
+	}
+}
+
+func TestV3ErrorBody(t *testing.T) {
+	s := newTestSeeker(t)
+	srv := useFakeCF(s, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(404)
+		w.Write([]byte(`{"errors":[{"code":10010,"title":"CF-ResourceNotFound","detail":"App not found"}]}`))
+	})
+	defer srv.Close()
+
+	var app v3App
+	err := s.cfGet("/v3/apps/nope", &app)
+	if !isNotFound(err) {
+		t.Fatalf("Expected a not found error, got %v", err)
+	}
+	if err.Error() != "cfclient: error (10010): CF-ResourceNotFound" {
+		t.Errorf("Expected the v3 error to be decoded, got `%s`", err)
+	}
+}
+
+func TestV3ListFollowsNextPage(t *testing.T) {
+	s := newTestSeeker(t)
+	srv := useFakeCF(s, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.RequestURI() {
+		case "/v3/apps/guid/processes":
+			//The next page may be given with a host other than the API address
+			w.Write([]byte(`{"pagination": {"next": {"href": "https://api.example.com/v3/apps/guid/processes?page=2&per_page=1"}}, "resources": [{"type": "web"}]}`))
+		case "/v3/apps/guid/processes?page=2&per_page=1":
+			w.Write([]byte(`{"pagination": {"next": null}, "resources": [{"type": "worker"}]}`))
+		default:
+			t.Errorf("Unexpected request for %s", r.URL.RequestURI())
+			w.WriteHeader(404)
+		}
+	})
+	defer srv.Close()
+
+	var types []string
+	var page []v3Process
+	err := s.v3List("/v3/apps/guid/processes", &page, func() {
+		for _, process := range page {
+			types = append(types, process.Type)
+		}
+	})
+	if err != nil {
+		t.Fatalf("Could not list processes: %s", err)
+	}
+	if strings.Join(types, " ") != "web worker" {
+		t.Errorf("Expected processes from both pages, got %v", types)
+	}
+}
+
+func TestAppInstanceFromV3Stats(t *testing.T) {
+	var stats v3ProcessStats
+	stats.State = "RUNNING"
+	stats.Host = "10.0.0.1"
+	stats.Usage.Time = "not a time"
+	stats.Usage.CPU = 0.5
+
+	inst, err := appInstanceFromV3Stats("web", stats)
+	if err != nil {
+		t.Fatalf("Expected an unreadable usage time not to fail, got %s", err)
+	}
+	if inst.Usage != nil {
+		t.Errorf("Expected usage with an unreadable time to be dropped, got %+v", inst.Usage)
+	}
+	if inst.Port != UnknownPort {
+		t.Errorf("Expected the port of an instance without instance_ports to be unknown, got %d", inst.Port)
+	}
+
+	stats.Usage.Time = "2017-05-02T17:23:18.123Z"
+	inst, err = appInstanceFromV3Stats("web", stats)
+	if err != nil {
+		t.Fatalf("Could not make instance: %s", err)
+	}
+	if inst.Usage == nil || inst.Usage.ReportedAt.IsZero() {
+		t.Errorf("Expected usage with its time, got %+v", inst.Usage)
+	}
+}
@@ -2,6 +2,8 @@ package seeker
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
 	"sync"
 	"time"
 
@@ -11,10 +13,26 @@ import (
 
 //VMCache contains the fields needed for caching VM information
 type VMCache struct {
-	data        map[string]*VMInfo
-	deployments map[string]*deploymentEntry //not nil if cached
+	//data is keyed by director and IP, since directors may use overlapping networks
+	data        map[vmKey]*VMInfo
+	deployments map[boshDeployment]*deploymentEntry //not nil if cached. Keyed by director and deployment name
 	ttl         time.Duration
 	lock        sync.Mutex
+	//refetches are the deployments being fetched again for fresh vitals
+	refetches map[boshDeployment]*refetch
+}
+
+//refetch is a fetch of a deployment for fresh vitals, which anyone else who
+// wants the deployment's vitals at the same time waits for
+type refetch struct {
+	done chan struct{}
+	err  error //only set once done is closed
+}
+
+//vmKey is the cache key of the VM with an IP on a particular director
+type vmKey struct {
+	Director string
+	IP       string
 }
 
 type deploymentEntry struct {
@@ -28,13 +46,195 @@ type VMInfo struct {
 	DeploymentName string
 	IP             string
 	Index          int
+	//AZ is the availability zone the VM is placed in. Empty if the director
+	// doesn't report one.
+	AZ string
+	//Director is the name of the BOSH director which deploys the VM
+	Director string
+	//ID is the UUID of the BOSH instance on the VM
+	ID           string
+	VMCID        string
+	AgentID      string
+	VMType       string
+	ResourcePool string
+	JobState     string
+	Bootstrap    bool
+	//Vitals are left out of cache snapshots, since they'd be stale by the
+	// time the snapshot is loaded
+	Vitals *VMVitals `json:"-"`
+}
+
+//VMVitals are the vitals of a VM as BOSH last reported them. Percentages are
+// out of 100.
+type VMVitals struct {
+	//Load holds the 1, 5, and 15 minute load averages
+	Load        []float64
+	CPUUser     float64
+	CPUSys      float64
+	CPUWait     float64
+	MemPercent  float64
+	MemKB       int
+	SwapPercent float64
+	SwapKB      int
+	//DiskPercent maps the name of each disk BOSH reported (system, ephemeral,
+	// or persistent) to how full it is
+	DiskPercent map[string]float64
+	Processes   []VMProcess
+	//ReportedAt is when the vitals were fetched from the BOSH director
+	ReportedAt time.Time
+}
+
+//VMProcess is a process monitored by the BOSH agent on a VM
+type VMProcess struct {
+	Name  string
+	State string
+}
+
+//vitalsTTL is how long the vitals fetched along with a deployment's VMs are used
+// before the deployment is fetched again for fresh ones
+const vitalsTTL = 30 * time.Second
+
+func vitalsFromBOSH(vm gogobosh.VM, at time.Time) *VMVitals {
+	v := vm.Vitals
+	ret := &VMVitals{
+		CPUUser:     parseVital(v.CPU.User),
+		CPUSys:      parseVital(v.CPU.Sys),
+		CPUWait:     parseVital(v.CPU.Wait),
+		MemPercent:  parseVital(v.Mem.Percent),
+		MemKB:       int(parseVital(v.Mem.KB)),
+		SwapPercent: parseVital(v.Swap.Percent),
+		SwapKB:      int(parseVital(v.Swap.KB)),
+		DiskPercent: map[string]float64{},
+		ReportedAt:  at,
+	}
+	for _, load := range v.Load {
+		ret.Load = append(ret.Load, parseVital(load))
+	}
+	for name, disk := range map[string]gogobosh.DiskStats{
+		"system":     v.Disk.System,
+		"ephemeral":  v.Disk.Ephemeral,
+		"persistent": v.Disk.Persistent,
+	} {
+		if disk.Percent != "" {
+			ret.DiskPercent[name] = parseVital(disk.Percent)
+		}
+	}
+	for _, proc := range vm.Processes {
+		ret.Processes = append(ret.Processes, VMProcess{Name: proc.Name, State: proc.State})
+	}
+	return ret
+}
+
+//FreshVitals returns the vitals of the given VM, fetching its deployment from
+// BOSH again if the cached vitals are older than vitalsTTL or were never
+// fetched, as for VMs loaded from a cache snapshot. If the deployment is already
+// being fetched again, that fetch is waited for instead of making another.
+func (s *Seeker) FreshVitals(vm *VMInfo) (*VMVitals, error) {
+	s.acquireLock()
+	current := s.vmcache.data[vm.key()]
+	s.releaseLock()
+	if current != nil && current.Vitals != nil && time.Since(current.Vitals.ReportedAt) < vitalsTTL {
+		return current.Vitals, nil
+	}
+
+	log.Debugf("Refreshing vitals of VM with IP (%s) in deployment (%s)", vm.IP, vm.deployment())
+	err := s.refetchDeployment(vm.deployment())
+	if err != nil {
+		return nil, fmt.Errorf("Error fetching vitals: %s", err.Error())
+	}
+
+	s.acquireLock()
+	defer s.releaseLock()
+	current = s.vmcache.data[vm.key()]
+	if current == nil {
+		return nil, fmt.Errorf("VM with IP `%s` is no longer in deployment `%s`", vm.IP, vm.DeploymentName)
+	}
+	return current.Vitals, nil
+}
+
+//refetchDeployment caches the deployment again, unless it is already being
+// cached again, in which case that is waited for
+func (s *Seeker) refetchDeployment(dep boshDeployment) error {
+	s.acquireLock()
+	if fetch, found := s.vmcache.refetches[dep]; found {
+		s.releaseLock()
+		log.Debugf("Waiting for deployment (%s) to be fetched again", dep)
+		<-fetch.done
+		return fetch.err
+	}
+	fetch := &refetch{done: make(chan struct{})}
+	s.vmcache.refetches[dep] = fetch
+	s.releaseLock()
+
+	fetch.err = s.cacheDeployment(dep)
+
+	s.acquireLock()
+	delete(s.vmcache.refetches, dep)
+	s.releaseLock()
+	close(fetch.done)
+	return fetch.err
+}
+
+//VitalsAlerts describes each of the given vitals which is at or above its
+// configured threshold, and each BOSH process on the VM which isn't running
+func (s *Seeker) VitalsAlerts(v *VMVitals) (ret []string) {
+	limits := s.config.Vitals
+	check := func(name string, value, limit float64) {
+		if limit > 0 && value >= limit {
+			ret = append(ret, fmt.Sprintf("%s is %.1f%% (threshold %.1f%%)", name, value, limit))
+		}
+	}
+
+	check("CPU", v.CPUUser+v.CPUSys+v.CPUWait, limits.CPUPercent)
+	check("memory", v.MemPercent, limits.MemPercent)
+	check("swap", v.SwapPercent, limits.SwapPercent)
+
+	disks := make([]string, 0, len(v.DiskPercent))
+	for disk := range v.DiskPercent {
+		disks = append(disks, disk)
+	}
+	sort.Strings(disks)
+	for _, disk := range disks {
+		check(disk+" disk", v.DiskPercent[disk], limits.DiskPercent)
+	}
+
+	if limits.Load > 0 && len(v.Load) > 0 && v.Load[0] >= limits.Load {
+		ret = append(ret, fmt.Sprintf("load is %.2f (threshold %.2f)", v.Load[0], limits.Load))
+	}
+
+	for _, proc := range v.Processes {
+		if proc.State != "running" {
+			ret = append(ret, fmt.Sprintf("process `%s` is %s", proc.Name, proc.State))
+		}
+	}
+	return
+}
+
+//parseVital reads a number out of the vitals reported by BOSH, which gives them
+// as strings. Anything unreadable is taken as zero.
+func parseVital(vital string) float64 {
+	ret, err := strconv.ParseFloat(vital, 64)
+	if err != nil {
+		return 0
+	}
+	return ret
+}
+
+//deployment returns the cache key of the deployment the VM is in
+func (v *VMInfo) deployment() boshDeployment {
+	return boshDeployment{Director: v.Director, Name: v.DeploymentName}
+}
+
+func (v *VMInfo) key() vmKey {
+	return vmKey{Director: v.Director, IP: v.IP}
 }
 
 func newVMCache() *VMCache {
 	return &VMCache{
-		data:        map[string]*VMInfo{},
-		deployments: map[string]*deploymentEntry{},
+		data:        map[vmKey]*VMInfo{},
+		deployments: map[boshDeployment]*deploymentEntry{},
 		ttl:         -1,
+		refetches:   map[boshDeployment]*refetch{},
 	}
 }
 
@@ -91,16 +291,41 @@ func (s *Seeker) SetTTL(ttl time.Duration) {
 	s.vmcache.ttl = ttl
 }
 
+//getFromCache returns the fresh cache entry for the VM with the given IP. If
+// more than one director has a VM with the IP, the one on the director
+// configured first is returned, and the collision is warned about.
 func (s *Seeker) getFromCache(host string) (ret *VMInfo) {
 	s.acquireLock()
 	defer s.releaseLock()
 	c := s.vmcache
-	if ret = c.data[host]; ret != nil {
-		dep := c.deployments[ret.DeploymentName]
+	var fresh []*VMInfo
+	for _, vm := range s.cachedWithIP(host) {
+		key := vm.deployment()
+		dep := c.deployments[key]
 		if age := time.Since(dep.cachedAt); c.ttl >= 0 && age >= c.ttl {
-			log.Debugf("Cached deployment (%s) deemed stale. Age: %s, TTL: %s", ret.DeploymentName, age, c.ttl)
-			s.invalidateDeployment(ret.DeploymentName)
-			ret = nil
+			log.Debugf("Cached deployment (%s) deemed stale. Age: %s, TTL: %s", key, age, c.ttl)
+			s.invalidateDeployment(key)
+			continue
+		}
+		fresh = append(fresh, vm)
+	}
+
+	if len(fresh) == 0 {
+		return nil
+	}
+	if len(fresh) > 1 {
+		log.Warnf("VMs with IP (%s) are cached from %d directors. Using the one on director (%s)", host, len(fresh), fresh[0].Director)
+	}
+	return fresh[0]
+}
+
+//cachedWithIP returns the cache entries for the VMs with the given IP on each
+// director, in the order the directors are configured
+// SYNC: Expected that you have the lock when you call this function.
+func (s *Seeker) cachedWithIP(ip string) (ret []*VMInfo) {
+	for _, director := range s.directors() {
+		if vm := s.vmcache.data[vmKey{Director: director.Name, IP: ip}]; vm != nil {
+			ret = append(ret, vm)
 		}
 	}
 	return
@@ -109,7 +334,7 @@ func (s *Seeker) getFromCache(host string) (ret *VMInfo) {
 // Just a helper function that invalidates a single dep.
 // Panicks if deployment isn't cached
 // SYNC: Expected that you have the lock when you call this function.
-func (s *Seeker) invalidateDeployment(name string) {
+func (s *Seeker) invalidateDeployment(name boshDeployment) {
 	log.Debugf("Invalidating cache for deployment (%s)", name)
 	c := s.vmcache
 	dep, found := c.deployments[name]
@@ -118,7 +343,7 @@ func (s *Seeker) invalidateDeployment(name string) {
 	}
 	//Delete each ip from the actual cache
 	for _, host := range dep.hosts {
-		delete(c.data, host)
+		delete(c.data, vmKey{Director: name.Director, IP: host})
 	}
 	//Delete the cache record for this deployment because it's not cached anymore
 	delete(c.deployments, name)
@@ -136,69 +361,285 @@ func (s *Seeker) cacheUntil(ip string) (ret *VMInfo, err error) {
 		return
 	}
 
-	for _, dep := range s.config.BOSH.Deployments {
-		if s.vmcache.deployments[dep] != nil { //if deployment is cached...
+	deployments, err := s.boshDeployments()
+	if err != nil {
+		return
+	}
+
+	for _, dep := range deployments {
+		if s.isCached(dep) {
 			continue
 		}
 
-		var vms []gogobosh.VM
-		//Go get the VMs in this particular deployment
-		log.Debugf("Contacting BOSH Director for VMs in deployment with name (%s)", dep)
-		vms, err = s.bosh.GetDeploymentVMs(dep)
+		err = s.cacheDeployment(dep)
 		if err != nil {
-			return ret, fmt.Errorf("Error while getting VMs for deployment `%s`: %s", dep, err.Error())
+			return
 		}
 
-		log.Debugf("Inserting VMs into local memory cache")
+		//Bail out if we got our target ip
+		s.acquireLock()
+		vm, found := s.vmcache.data[vmKey{Director: dep.Director, IP: ip}]
+		s.releaseLock()
+		if found {
+			log.Debugf("Found target IP (%s) in deployment (%s)", ip, dep)
+			return vm, nil
+		}
+	}
+	log.Debugf("Fetched all deployments but didn't find IP (%s)", ip)
+	return
+}
 
-		vmsInDeployment := []string{}
+//cacheAll makes sure that every configured deployment is in the cache,
+// refetching any deployments whose entries have gone stale.
+func (s *Seeker) cacheAll() (err error) {
+	s.acquireLock()
+	c := s.vmcache
+	for name, dep := range c.deployments {
+		if age := time.Since(dep.cachedAt); c.ttl >= 0 && age >= c.ttl {
+			log.Debugf("Cached deployment (%s) deemed stale. Age: %s, TTL: %s", name, age, c.ttl)
+			s.invalidateDeployment(name)
+		}
+	}
+	s.releaseLock()
 
-		s.acquireLock()
-		//Populate the cache with the VMs we got
-		for _, vm := range vms {
-			//Cache every ip address for this VM as this VM
-			for idx, ip := range vm.IPs {
-				vm.IPs[idx], err = canonizeIP(ip)
-				if err != nil {
-					s.releaseLock()
-					return
-				}
-				vmsInDeployment = append(vmsInDeployment, ip)
-				s.vmcache.data[ip] = &VMInfo{
-					JobName:        vm.JobName,
-					DeploymentName: dep,
-					IP:             ip,
-					Index:          vm.Index,
+	deployments, err := s.boshDeployments()
+	if err != nil {
+		return
+	}
+
+	for _, dep := range deployments {
+		if s.isCached(dep) {
+			continue
+		}
+
+		err = s.cacheDeployment(dep)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+func (s *Seeker) isCached(deployment boshDeployment) bool {
+	s.acquireLock()
+	defer s.releaseLock()
+	return s.vmcache.deployments[deployment] != nil
+}
+
+//cacheDeployment fetches the VMs in the given deployment from its BOSH director
+// and stores them in the cache, marking the deployment as cached. Anything
+// previously cached for the deployment is replaced.
+func (s *Seeker) cacheDeployment(dep boshDeployment) (err error) {
+	var vms []gogobosh.VM
+	//Go get the VMs in this particular deployment
+	log.Debugf("Contacting BOSH Director (%s) for VMs in deployment with name (%s)", dep.Director, dep.Name)
+	vms, err = s.bosh[dep.Director].GetDeploymentVMs(dep.Name)
+	if err != nil {
+		return fmt.Errorf("Error while getting VMs for deployment `%s` from director `%s`: %s", dep.Name, dep.Director, err.Error())
+	}
+
+	log.Debugf("Inserting VMs into local memory cache")
+
+	vmsInDeployment := []string{}
+	fetchedAt := time.Now()
+
+	s.acquireLock()
+	defer s.releaseLock()
+	//Drop whatever we had cached for this deployment before
+	if s.vmcache.deployments[dep] != nil {
+		s.invalidateDeployment(dep)
+	}
+	//Populate the cache with the VMs we got
+	for _, vm := range vms {
+		//Cache every ip address for this VM as this VM
+		for _, ip := range vm.IPs {
+			ip, err = canonizeIP(ip)
+			if err != nil {
+				return
+			}
+			vmsInDeployment = append(vmsInDeployment, ip)
+			s.vmcache.data[vmKey{Director: dep.Director, IP: ip}] = &VMInfo{
+				JobName:        vm.JobName,
+				DeploymentName: dep.Name,
+				Director:       dep.Director,
+				IP:             ip,
+				Index:          vm.Index,
+				AZ:             vm.AZ,
+				ID:             vm.ID,
+				VMCID:          vm.VMCID,
+				AgentID:        vm.AgentID,
+				VMType:         vm.VMType,
+				ResourcePool:   vm.ResourcePool,
+				JobState:       vm.JobState,
+				Bootstrap:      vm.Bootstrap,
+				Vitals:         vitalsFromBOSH(vm, fetchedAt),
+			}
+		}
+	}
+	log.Debugf("Cached %d VMs", len(vms))
+
+	//Mark that we cached this deployment
+	s.vmcache.deployments[dep] = &deploymentEntry{
+		hosts:    vmsInDeployment,
+		cachedAt: fetchedAt,
+	}
+	return
+}
+
+// GetVMsWithName returns the cache entries for the BOSH VM with the given job
+// name and index, fetching any configured deployments that aren't cached. There
+// is one entry for each IP address of the VM. If deployment is empty, matching
+// VMs in all configured or discovered deployments are returned. The entries are
+// sorted by director, deployment, and IP.
+func (s *Seeker) GetVMsWithName(deployment, job string, index int) (vms []*VMInfo, err error) {
+	log.Debugf("Getting VM with name (%s/%d) in deployment (%s)", job, index, deployment)
+	err = s.cacheAll()
+	if err != nil {
+		err = fmt.Errorf("Error fetching VMs: %s", err.Error())
+		return
+	}
+
+	deployments, err := s.boshDeployments()
+	if err != nil {
+		err = fmt.Errorf("Error listing deployments: %s", err.Error())
+		return
+	}
+
+	s.acquireLock()
+	defer s.releaseLock()
+	for _, dep := range deployments {
+		entry := s.vmcache.deployments[dep]
+		if entry == nil || (deployment != "" && dep.Name != deployment) {
+			continue
+		}
+		for _, host := range entry.hosts {
+			vm := s.vmcache.data[vmKey{Director: dep.Director, IP: host}]
+			if vm != nil && vm.DeploymentName == dep.Name && vm.JobName == job && vm.Index == index {
+				vms = append(vms, vm)
+			}
+		}
+	}
+	sort.Sort(vmsByLocation(vms))
+	return
+}
+
+type vmsByLocation []*VMInfo
+
+func (v vmsByLocation) Len() int      { return len(v) }
+func (v vmsByLocation) Swap(i, j int) { v[i], v[j] = v[j], v[i] }
+func (v vmsByLocation) Less(i, j int) bool {
+	if v[i].Director != v[j].Director {
+		return v[i].Director < v[j].Director
+	}
+	if v[i].DeploymentName != v[j].DeploymentName {
+		return v[i].DeploymentName < v[j].DeploymentName
+	}
+	return v[i].IP < v[j].IP
+}
+
+//GetDeploymentVMs returns the cache entries for every VM in the BOSH deployment
+// with the given name, whether or not it is a configured deployment. Each
+// director is asked whether it has the deployment until one does, and its VMs
+// are fetched into the cache if they aren't cached already. There is one entry
+// for each IP address of each VM. The name of the director with the deployment
+// is returned, or an empty string if no director has it.
+func (s *Seeker) GetDeploymentVMs(name string) (director string, vms []*VMInfo, err error) {
+	log.Debugf("Getting VMs in deployment (%s)", name)
+	if !s.BOSHConfigured() {
+		err = fmt.Errorf("BOSH is not configured")
+		return
+	}
+
+	var dep boshDeployment
+	var found bool
+	for _, d := range s.directors() {
+		candidate := boshDeployment{Director: d.Name, Name: name}
+		if s.isFresh(candidate) {
+			dep, found = candidate, true
+			break
+		}
+	}
+
+	if !found {
+		for _, d := range s.directors() {
+			log.Debugf("Asking BOSH Director (%s) if it has deployment (%s)", d.Name, name)
+			var deployments []gogobosh.Deployment
+			deployments, err = s.bosh[d.Name].GetDeployments()
+			if err != nil {
+				err = fmt.Errorf("Error while listing deployments on director `%s`: %s", d.Name, err.Error())
+				return
+			}
+			for _, deployment := range deployments {
+				if deployment.Name == name {
+					dep, found = boshDeployment{Director: d.Name, Name: name}, true
+					break
 				}
 			}
+			if found {
+				break
+			}
+		}
+		if !found {
+			log.Debugf("No director has deployment (%s)", name)
+			return
 		}
-		log.Debugf("Cached %d VMs", len(vms))
 
-		//Mark that we cached this deployment
-		s.vmcache.deployments[dep] = &deploymentEntry{
-			hosts:    vmsInDeployment,
-			cachedAt: time.Now(),
+		err = s.cacheDeployment(dep)
+		if err != nil {
+			return
 		}
+	}
 
-		//Bail out if we got our target ip
-		if vm, found := s.vmcache.data[ip]; found {
-			log.Debugf("Found target IP (%s) in deployment (%s)", ip, dep)
-			s.releaseLock()
-			return vm, nil
+	s.acquireLock()
+	defer s.releaseLock()
+	director = dep.Director
+	entry := s.vmcache.deployments[dep]
+	if entry == nil {
+		return
+	}
+	for _, host := range entry.hosts {
+		vms = append(vms, s.vmcache.data[vmKey{Director: dep.Director, IP: host}])
+	}
+	return
+}
+
+//GetCachedVMsWithIPs makes sure every configured deployment is cached, and then
+// returns the cache entries for whichever of the given IPs are in the cache,
+// keyed by IP. Unlike GetVMWithIP, the cache isn't refreshed when an IP can't be
+// found, because the IPs given are expected to include ones outside of BOSH.
+func (s *Seeker) GetCachedVMsWithIPs(ips []string) (ret map[string]*VMInfo, err error) {
+	err = s.cacheAll()
+	if err != nil {
+		err = fmt.Errorf("Error fetching VMs: %s", err.Error())
+		return
+	}
+
+	ret = map[string]*VMInfo{}
+	for _, ip := range ips {
+		if vm := s.getFromCache(ip); vm != nil {
+			ret[ip] = vm
 		}
-		s.releaseLock()
 	}
-	log.Debugf("Fetched all deployments but didn't find IP (%s)", ip)
 	return
 }
 
+//isFresh returns true if the given deployment is cached and its entry hasn't
+// gone stale
+func (s *Seeker) isFresh(deployment boshDeployment) bool {
+	s.acquireLock()
+	defer s.releaseLock()
+	c := s.vmcache
+	dep := c.deployments[deployment]
+	return dep != nil && (c.ttl < 0 || time.Since(dep.cachedAt) < c.ttl)
+}
+
 //InvalidateAll wipes the entire cache
 func (s *Seeker) InvalidateAll() {
 	log.Debugf("Invalidating cache for Seeker (%p)", s)
 	s.acquireLock()
 	defer s.releaseLock()
-	s.vmcache.data = map[string]*VMInfo{}
-	s.vmcache.deployments = map[string]*deploymentEntry{}
+	s.vmcache.data = map[vmKey]*VMInfo{}
+	s.vmcache.deployments = map[boshDeployment]*deploymentEntry{}
 	log.Debugf("Cache invalidated for Seeker (%p)", s)
 }
 
@@ -0,0 +1,180 @@
+package seeker
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cloudfoundry-community/gogobosh"
+)
+
+func TestOverlappingIPsAcrossDirectors(t *testing.T) {
+	main := newFakeDirector(map[string][]gogobosh.VM{
+		"cf": {testVM("diego_cell", 0, "10.0.0.1"), testVM("router", 0, "10.0.0.2")},
+	})
+	defer main.Close()
+	iso := newFakeDirector(map[string][]gogobosh.VM{
+		"iso": {testVM("iso_cell", 3, "10.0.0.1")},
+	})
+	defer iso.Close()
+
+	s := newTestSeeker(t, main.directorConfig("main", "cf"), iso.directorConfig("iso", "iso"))
+	err := s.cacheAll()
+	if err != nil {
+		t.Fatalf("Could not cache deployments: %s", err)
+	}
+
+	for _, test := range []struct {
+		director, job string
+	}{
+		{"main", "diego_cell"},
+		{"iso", "iso_cell"},
+	} {
+		vm := s.vmcache.data[vmKey{Director: test.director, IP: "10.0.0.1"}]
+		if vm == nil || vm.JobName != test.job || vm.Director != test.director {
+			t.Errorf("Expected %s on director %s to be cached at 10.0.0.1, got %+v", test.job, test.director, vm)
+		}
+	}
+
+	vm, err := s.GetVMWithIP("10.0.0.1")
+	if err != nil {
+		t.Fatalf("Could not get VM: %s", err)
+	}
+	if vm == nil || vm.Director != "main" {
+		t.Errorf("Expected the VM on the director configured first, got %+v", vm)
+	}
+
+	s.acquireLock()
+	s.invalidateDeployment(boshDeployment{Director: "iso", Name: "iso"})
+	s.releaseLock()
+	if s.vmcache.data[vmKey{Director: "main", IP: "10.0.0.1"}] == nil {
+		t.Errorf("Invalidating a deployment on one director dropped a VM of another")
+	}
+
+	vms, err := s.GetVMsWithName("", "iso_cell", 3)
+	if err != nil {
+		t.Fatalf("Could not get VMs by name: %s", err)
+	}
+	if len(vms) != 1 || vms[0].Director != "iso" {
+		t.Errorf("Expected iso_cell/3 to be recached from director iso, got %+v", vms)
+	}
+}
+
+func TestFreshVitals(t *testing.T) {
+	director := newFakeDirector(map[string][]gogobosh.VM{
+		"cf": {testVM("diego_cell", 0, "10.0.0.1")},
+	})
+	defer director.Close()
+
+	s := newTestSeeker(t, director.directorConfig("main", "cf"))
+	vm, err := s.GetVMWithIP("10.0.0.1")
+	if err != nil || vm == nil {
+		t.Fatalf("Could not get VM: %v, %s", vm, err)
+	}
+
+	_, err = s.FreshVitals(vm)
+	if err != nil {
+		t.Fatalf("Could not get vitals: %s", err)
+	}
+	if listings := director.listings("cf"); listings != 1 {
+		t.Errorf("Expected vitals fetched with the VM to be used, but VMs were listed %d times", listings)
+	}
+
+	vm.Vitals.ReportedAt = time.Now().Add(-vitalsTTL)
+	vitals, err := s.FreshVitals(vm)
+	if err != nil {
+		t.Fatalf("Could not get vitals: %s", err)
+	}
+	if listings := director.listings("cf"); listings != 2 {
+		t.Errorf("Expected stale vitals to be fetched again, but VMs were listed %d times", listings)
+	}
+	if time.Since(vitals.ReportedAt) >= vitalsTTL {
+		t.Errorf("Expected fresh vitals, got vitals reported at %s", vitals.ReportedAt)
+	}
+
+	snap, err := json.Marshal(s.SnapshotVMCache())
+	if err != nil {
+		t.Fatalf("Could not marshal snapshot: %s", err)
+	}
+	if strings.Contains(string(snap), "Vitals") {
+		t.Errorf("Expected vitals to be left out of the snapshot, got %s", snap)
+	}
+}
+
+func TestFreshVitalsSharesRefetches(t *testing.T) {
+	director := newFakeDirector(map[string][]gogobosh.VM{
+		"cf": {testVM("diego_cell", 0, "10.0.0.1"), testVM("diego_cell", 1, "10.0.0.2")},
+	})
+	defer director.Close()
+	director.listingDelay = 50 * time.Millisecond
+
+	s := newTestSeeker(t, director.directorConfig("main", "cf"))
+	var vms []*VMInfo
+	for _, ip := range []string{"10.0.0.1", "10.0.0.2"} {
+		vm, err := s.GetVMWithIP(ip)
+		if err != nil || vm == nil {
+			t.Fatalf("Could not get VM: %v, %s", vm, err)
+		}
+		vm.Vitals.ReportedAt = time.Now().Add(-vitalsTTL)
+		vms = append(vms, vm)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func(vm *VMInfo) {
+			defer wg.Done()
+			vitals, err := s.FreshVitals(vm)
+			if err != nil || time.Since(vitals.ReportedAt) >= vitalsTTL {
+				t.Errorf("Expected fresh vitals, got %+v, %v", vitals, err)
+			}
+		}(vms[i%len(vms)])
+	}
+	wg.Wait()
+
+	if listings := director.listings("cf"); listings != 2 {
+		t.Errorf("Expected one shared fetch of stale vitals, but VMs were listed %d times", listings)
+	}
+}
+
+func TestGetVMsWithName(t *testing.T) {
+	zeta := newFakeDirector(map[string][]gogobosh.VM{
+		"cf":      {testVM("router", 0, "10.0.1.1")},
+		"routing": {testVM("router", 0, "10.0.1.3", "10.0.1.2")},
+		"other":   {testVM("router", 0, "10.0.1.4")},
+	})
+	defer zeta.Close()
+	alpha := newFakeDirector(map[string][]gogobosh.VM{
+		"cf": {testVM("router", 0, "10.0.0.1"), testVM("router", 1, "10.0.0.2")},
+	})
+	defer alpha.Close()
+
+	s := newTestSeeker(t, zeta.directorConfig("zeta", "routing", "cf"), alpha.directorConfig("alpha", "cf"))
+	_, _, err := s.GetDeploymentVMs("other")
+	if err != nil {
+		t.Fatalf("Could not get VMs of unconfigured deployment: %s", err)
+	}
+
+	for _, test := range []struct {
+		deployment string
+		want       []string
+	}{
+		{"", []string{"alpha/cf/10.0.0.1", "zeta/cf/10.0.1.1", "zeta/routing/10.0.1.2", "zeta/routing/10.0.1.3"}},
+		{"routing", []string{"zeta/routing/10.0.1.2", "zeta/routing/10.0.1.3"}},
+		{"other", nil},
+	} {
+		vms, err := s.GetVMsWithName(test.deployment, "router", 0)
+		if err != nil {
+			t.Fatalf("Could not get VMs by name: %s", err)
+		}
+		var got []string
+		for _, vm := range vms {
+			got = append(got, vm.Director+"/"+vm.DeploymentName+"/"+vm.IP)
+		}
+		if strings.Join(got, " ") != strings.Join(test.want, " ") {
+			t.Errorf("Deployment %q: expected router/0 at %v, got %v", test.deployment, test.want, got)
+		}
+	}
+}